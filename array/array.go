@@ -26,6 +26,9 @@ import (
 	"github.com/andrei-cosmin/sandata/mathutil"
 )
 
+// clearBatchSize the number of indexes extracted at once from a mask when clearing values
+const clearBatchSize = 256
+
 // Array a generic array implementation
 //   - container []T - the array container
 //   - empty T - the empty value for the array
@@ -59,17 +62,29 @@ func (a *Array[T]) Size() uint {
 
 // ClearAll clears all the values in the array according to the set bits
 func (a *Array[T]) ClearAll(bits bit.Mask) {
-	for index, hasNext := bits.NextSet(0); hasNext && index < uint(len(a.container)); index, hasNext = bits.NextSet(index + 1) {
-		a.container[index] = a.empty
+	buffer := make([]uint, clearBatchSize)
+	for index, batch := bits.NextSetMany(0, buffer); len(batch) > 0; index, batch = bits.NextSetMany(index+1, batch) {
+		for _, key := range batch {
+			if key >= uint(len(a.container)) {
+				return
+			}
+			a.container[key] = a.empty
+		}
 	}
 }
 
 // ClearAllFunc clears all the values in the array according to the set bits
 // and calls `f` for each cleared value
 func (a *Array[T]) ClearAllFunc(bits bit.Mask, f func(T)) {
-	for index, hasNext := bits.NextSet(0); hasNext && index < uint(len(a.container)); index, hasNext = bits.NextSet(index + 1) {
-		f(a.container[index])
-		a.container[index] = a.empty
+	buffer := make([]uint, clearBatchSize)
+	for index, batch := bits.NextSetMany(0, buffer); len(batch) > 0; index, batch = bits.NextSetMany(index+1, batch) {
+		for _, key := range batch {
+			if key >= uint(len(a.container)) {
+				return
+			}
+			f(a.container[key])
+			a.container[key] = a.empty
+		}
 	}
 }
 
@@ -94,3 +94,35 @@ func TestArray_ClearAllFunc(t *testing.T) {
 		assert.Equal(t, uint(0), array.Get(keys[index]))
 	}
 }
+
+func TestArray_ClearAllBitmap(t *testing.T) {
+	keys := testutil.RandomUInts(numArrayElements, maxRandomValue)
+	array := New[uint](defaultSize)
+
+	bitmap := bit.BitmapOf(keys...)
+	for index := range numArrayElements {
+		array.Set(keys[index], keys[index])
+	}
+
+	array.ClearAll(bitmap)
+
+	for index := range numArrayElements {
+		assert.Equal(t, uint(0), array.Get(keys[index]))
+	}
+}
+
+func BenchmarkArray_ClearAll(b *testing.B) {
+	const size = 1 << 20
+	array := New[uint](size)
+	bits := bitset.New(size)
+	for index := range uint(size) {
+		if rand.IntN(2) == 0 {
+			bits.Set(index)
+		}
+	}
+	mask := bit.NewMask(bits)
+
+	for b.Loop() {
+		array.ClearAll(mask)
+	}
+}
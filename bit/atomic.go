@@ -0,0 +1,153 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"math/bits"
+	"runtime"
+	"sync/atomic"
+
+	"github.com/bits-and-blooms/bitset"
+)
+
+const (
+	// epochWriters the mask extracting the number of writes in flight from the epoch (the low half)
+	epochWriters = 1<<32 - 1
+
+	// epochStep the increment of the epoch on each completed write (the high half counts the writes)
+	epochStep = 1 << 32
+)
+
+// Atomic represents a fixed size bitmap, with lock-free single bit operations
+//   - words []atomic.Uint64 - the words of the bitmap (64 bits each, as in a bitset)
+//   - length uint - the number of bits in the bitmap
+//   - epoch atomic.Uint64 - the number of completed writes (high half) and of writes in flight (low half)
+//
+// NOTE: Set, Clear, TestAndSet and TestAndClear are a single atomic Or / And on the word holding the bit,
+// surrounded by two increments of the epoch (a seqlock shared by all writers, which never wait for each
+// other or for readers); writes which would not change the bit skip the epoch. Snapshot and Count copy
+// the words when no write is in flight, and retry until the epoch did not change during the copy, so they
+// are consistent but can be delayed by a continuous stream of writes.
+//
+//	epoch = [completed writes (32 bits)][writes in flight (32 bits)]
+type Atomic struct {
+	words  []atomic.Uint64
+	length uint
+	epoch  atomic.Uint64
+}
+
+// NewAtomic creates a new atomic bitmap with the given number of bits (all clear)
+func NewAtomic(length uint) *Atomic {
+	return &Atomic{
+		words:  make([]atomic.Uint64, (length+wordSize-1)/wordSize),
+		length: length,
+	}
+}
+
+// Len returns the number of bits in the bitmap
+func (a *Atomic) Len() uint {
+	return a.length
+}
+
+// Set sets the bit at the given index (ignored when index >= Len())
+func (a *Atomic) Set(index uint) {
+	a.update(index, true)
+}
+
+// Clear clears the bit at the given index (ignored when index >= Len())
+func (a *Atomic) Clear(index uint) {
+	a.update(index, false)
+}
+
+// Test returns whether the bit at the given index is set
+func (a *Atomic) Test(index uint) bool {
+	if index >= a.length {
+		return false
+	}
+	return a.words[index/wordSize].Load()&(1<<(index%wordSize)) != 0
+}
+
+// TestAndSet sets the bit at the given index, and returns whether it was already set
+// (returns false, without effects, when index >= Len())
+func (a *Atomic) TestAndSet(index uint) bool {
+	return a.update(index, true)
+}
+
+// TestAndClear clears the bit at the given index, and returns whether it was set
+// (returns false, without effects, when index >= Len())
+func (a *Atomic) TestAndClear(index uint) bool {
+	return a.update(index, false)
+}
+
+// Count returns the number of set bits (of a consistent view of the bitmap)
+func (a *Atomic) Count() uint {
+	count := 0
+	for _, word := range a.read() {
+		count += bits.OnesCount64(word)
+	}
+	return uint(count)
+}
+
+// Snapshot returns a consistent copy of the bitmap, as a bitset of the same length
+func (a *Atomic) Snapshot() *bitset.BitSet {
+	return bitset.FromWithLength(a.length, a.read())
+}
+
+// Mask returns a bit.Mask view over a snapshot of the bitmap (usable with array.Array.ClearAll)
+func (a *Atomic) Mask() *BitMask {
+	return NewMask(a.Snapshot())
+}
+
+// update sets (or clears) the bit at the given index, and returns whether it was set before
+func (a *Atomic) update(index uint, set bool) bool {
+	if index >= a.length {
+		return false
+	}
+
+	word, bit := &a.words[index/wordSize], uint64(1)<<(index%wordSize)
+	if was := word.Load()&bit != 0; was == set {
+		return was
+	}
+
+	a.epoch.Add(1)
+	defer a.epoch.Add(epochStep - 1)
+
+	if set {
+		return word.Or(bit)&bit != 0
+	}
+	return word.And(^bit)&bit != 0
+}
+
+// read returns a consistent copy of the words of the bitmap
+func (a *Atomic) read() []uint64 {
+	words := make([]uint64, len(a.words))
+
+	for {
+		if epoch := a.epoch.Load(); epoch&epochWriters == 0 {
+			for index := range a.words {
+				words[index] = a.words[index].Load()
+			}
+			if a.epoch.Load() == epoch {
+				return words
+			}
+		}
+		runtime.Gosched()
+	}
+}
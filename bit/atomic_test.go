@@ -0,0 +1,148 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtomic(t *testing.T) {
+	bitmap := NewAtomic(130)
+	assert.Equal(t, uint(130), bitmap.Len())
+
+	assert.False(t, bitmap.TestAndSet(129))
+	assert.True(t, bitmap.TestAndSet(129))
+	bitmap.Set(3)
+	assert.True(t, bitmap.Test(3))
+	assert.False(t, bitmap.Test(4))
+	assert.False(t, bitmap.Test(1000))
+	assert.Equal(t, uint(2), bitmap.Count())
+
+	assert.True(t, bitmap.TestAndClear(3))
+	assert.False(t, bitmap.TestAndClear(3))
+	bitmap.Clear(129)
+	assert.Equal(t, uint(0), bitmap.Count())
+
+	bitmap.Set(64)
+	snapshot := bitmap.Snapshot()
+	assert.Equal(t, uint(130), snapshot.Len())
+	assert.Equal(t, "{64}", snapshot.String())
+
+	bitmap.Set(65)
+	assert.Equal(t, "{64}", snapshot.String())
+	assert.Equal(t, uint(2), bitmap.Mask().Count())
+}
+
+func TestAtomic_Bounds(t *testing.T) {
+	bitmap := NewAtomic(130)
+
+	bitmap.Set(130)
+	bitmap.Set(159)
+	assert.False(t, bitmap.TestAndSet(131))
+	assert.False(t, bitmap.TestAndClear(131))
+	bitmap.Clear(1000)
+
+	assert.Equal(t, uint(0), bitmap.Count())
+	assert.Equal(t, uint(0), bitmap.Snapshot().Count())
+	assert.False(t, bitmap.Test(131))
+}
+
+func TestAtomic_Consistent(t *testing.T) {
+	bitmap := NewAtomic(maxBitIndex)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for index := range uint(maxBitIndex) {
+			bitmap.Set(index)
+		}
+	}()
+
+	// the bits are set in order, so every consistent view is a prefix
+	for range 100 {
+		snapshot := bitmap.Snapshot()
+		count := snapshot.Count()
+		if count > 0 {
+			assert.Equal(t, count-1, snapshot.Select(count-1))
+		}
+	}
+	<-done
+}
+
+func TestAtomic_ConcurrentReads(t *testing.T) {
+	const workers = 4
+	bitmap := NewAtomic(numBits)
+
+	var stop atomic.Bool
+	var group sync.WaitGroup
+	for worker := range workers {
+		group.Go(func() {
+			for index := uint(worker); !stop.Load(); index = (index + workers) % numBits {
+				bitmap.Set(index)
+				bitmap.Clear(index)
+				runtime.Gosched()
+			}
+		})
+	}
+
+	// each writer has at most one bit set at a time, so every consistent view has at most one bit per writer
+	for range 1000 {
+		assert.LessOrEqual(t, bitmap.Count(), uint(workers))
+	}
+	stop.Store(true)
+	group.Wait()
+	assert.Equal(t, uint(0), bitmap.Count())
+}
+
+func TestAtomic_Concurrent(t *testing.T) {
+	const workers = 8
+	bitmap := NewAtomic(maxBitIndex)
+
+	var group sync.WaitGroup
+	claimed := make([]int, workers)
+	for worker := range workers {
+		group.Go(func() {
+			for index := range uint(maxBitIndex) {
+				if !bitmap.TestAndSet(index) {
+					claimed[worker]++
+				}
+			}
+		})
+	}
+
+	for range 10 {
+		count := bitmap.Count()
+		assert.LessOrEqual(t, count, uint(maxBitIndex))
+		assert.LessOrEqual(t, bitmap.Snapshot().Count(), uint(maxBitIndex))
+	}
+	group.Wait()
+
+	total := 0
+	for _, count := range claimed {
+		total += count
+	}
+	assert.Equal(t, maxBitIndex, total)
+	assert.True(t, bitmap.Mask().All())
+}
@@ -0,0 +1,374 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"iter"
+	"math/bits"
+
+	"github.com/bits-and-blooms/bitset"
+)
+
+// wordSize the number of bits in a word of a bitset
+const wordSize = 64
+
+// Bitmap represents a mutable bitmap, whose set operations never modify their operands
+//   - bits *bitset.BitSet - the bits of the bitmap
+//
+// NOTE: The operations returning a new bitmap (Or, And, AndNot, Xor) leave both operands untouched, while their
+// InPlace variants only modify the receiver
+//
+//	a.And(b) -> a & b (new bitmap)
+//	a.InPlaceAnd(b) -> a = a & b
+//
+// WARNING: The bit.Mask methods (Difference, Intersection, Union, SymmetricalDifference) keep the semantics
+// of the Mask interface, and modify the given bitset
+type Bitmap struct {
+	bits *bitset.BitSet
+}
+
+// NewBitmap creates a new empty bitmap, with pre-allocated memory for the given number of bits
+func NewBitmap(length uint) *Bitmap {
+	return &Bitmap{
+		bits: bitset.New(length),
+	}
+}
+
+// BitmapOf creates a new bitmap with the given bits set
+func BitmapOf(indexes ...uint) *Bitmap {
+	b := NewBitmap(0)
+	for _, index := range indexes {
+		b.Set(index)
+	}
+	return b
+}
+
+// BitmapFrom creates a new bitmap over the given bitset (the bitmap takes ownership of the bitset)
+func BitmapFrom(bits *bitset.BitSet) *Bitmap {
+	return &Bitmap{
+		bits: bits,
+	}
+}
+
+// Bits returns the underlying bitset
+func (b *Bitmap) Bits() *bitset.BitSet {
+	return b.bits
+}
+
+// Set sets the bit at the given index (the bitmap will automatically grow if the index is out of bounds)
+func (b *Bitmap) Set(index uint) {
+	b.bits.Set(index)
+}
+
+// Clear clears the bit at the given index
+func (b *Bitmap) Clear(index uint) {
+	b.bits.Clear(index)
+}
+
+// Flip flips the bit at the given index (the bitmap will automatically grow if the index is out of bounds)
+func (b *Bitmap) Flip(index uint) {
+	b.bits.Flip(index)
+}
+
+// SetRange sets the bits in the range [start, end)
+func (b *Bitmap) SetRange(start, end uint) {
+	if start >= end {
+		return
+	}
+
+	b.bits.Set(end - 1)
+	applyRange(b.bits.Words(), start, end, setMask)
+}
+
+// ClearRange clears the bits in the range [start, end)
+func (b *Bitmap) ClearRange(start, end uint) {
+	end = min(end, b.bits.Len())
+	if start >= end {
+		return
+	}
+
+	applyRange(b.bits.Words(), start, end, clearMask)
+}
+
+// FlipRange flips the bits in the range [start, end)
+func (b *Bitmap) FlipRange(start, end uint) {
+	b.bits.FlipRange(start, end)
+}
+
+// Ones returns an iterator over the indexes of the set bits, in ascending order
+func (b *Bitmap) Ones() iter.Seq[uint] {
+	return func(yield func(uint) bool) {
+		for index, word := range b.bits.Words() {
+			for word != 0 {
+				if !yield(uint(index*wordSize + bits.TrailingZeros64(word))) {
+					return
+				}
+				word &= word - 1
+			}
+		}
+	}
+}
+
+// Or returns a new bitmap representing the union of the current and given bitmaps
+//
+//	result = b | other
+func (b *Bitmap) Or(other *Bitmap) *Bitmap {
+	return &Bitmap{bits: b.bits.Union(other.bits)}
+}
+
+// And returns a new bitmap representing the intersection of the current and given bitmaps
+//
+//	result = b & other
+func (b *Bitmap) And(other *Bitmap) *Bitmap {
+	return &Bitmap{bits: b.bits.Intersection(other.bits)}
+}
+
+// AndNot returns a new bitmap representing the difference between the current and given bitmaps
+//
+//	result = b & (~other)
+func (b *Bitmap) AndNot(other *Bitmap) *Bitmap {
+	return &Bitmap{bits: b.bits.Difference(other.bits)}
+}
+
+// Xor returns a new bitmap representing the symmetrical difference of the current and given bitmaps
+//
+//	result = b ^ other
+func (b *Bitmap) Xor(other *Bitmap) *Bitmap {
+	return &Bitmap{bits: b.bits.SymmetricDifference(other.bits)}
+}
+
+// InPlaceOr performs the union with the given bitmap, modifying the current bitmap
+//
+//	b = b | other
+func (b *Bitmap) InPlaceOr(other *Bitmap) {
+	b.bits.InPlaceUnion(other.bits)
+}
+
+// InPlaceAnd performs the intersection with the given bitmap, modifying the current bitmap
+//
+//	b = b & other
+func (b *Bitmap) InPlaceAnd(other *Bitmap) {
+	b.bits.InPlaceIntersection(other.bits)
+}
+
+// InPlaceAndNot performs the difference with the given bitmap, modifying the current bitmap
+//
+//	b = b & (~other)
+func (b *Bitmap) InPlaceAndNot(other *Bitmap) {
+	b.bits.InPlaceDifference(other.bits)
+}
+
+// InPlaceXor performs the symmetrical difference with the given bitmap, modifying the current bitmap
+//
+//	b = b ^ other
+func (b *Bitmap) InPlaceXor(other *Bitmap) {
+	b.bits.InPlaceSymmetricDifference(other.bits)
+}
+
+// Len returns the number of bits in the bitmap
+func (b *Bitmap) Len() uint {
+	return b.bits.Len()
+}
+
+// Test returns whether the bit at the given index is set
+func (b *Bitmap) Test(index uint) bool {
+	return b.bits.Test(index)
+}
+
+// String returns a string representation of the bitmap
+func (b *Bitmap) String() string {
+	return b.bits.String()
+}
+
+// NextSet returns the next bit set from the specified index, including possibly the current index
+// along with an error code (true = valid, false = no set bit found, i.e all bits are clear)
+func (b *Bitmap) NextSet(index uint) (uint, bool) {
+	return b.bits.NextSet(index)
+}
+
+// NextClear returns the next bit clear from the specified index, including possibly the current index
+// along with an error code (true = valid, false = no clear bit found, i.e all bits are set)
+func (b *Bitmap) NextClear(index uint) (uint, bool) {
+	return b.bits.NextClear(index)
+}
+
+// NextSetMany returns many next bit sets from the specified index, including possibly the current index
+// and up to cap(buffer), along with the last index found (an empty slice means no set bit was found)
+func (b *Bitmap) NextSetMany(index uint, buffer []uint) (uint, []uint) {
+	return b.bits.NextSetMany(index, buffer)
+}
+
+// Clone returns a new BitSet with the same bits set and same size
+func (b *Bitmap) Clone() *bitset.BitSet {
+	return b.bits.Clone()
+}
+
+// Copy copies bits into a destination BitSet (using the Go array copy semantics)
+func (b *Bitmap) Copy(other *bitset.BitSet) uint {
+	return b.bits.Copy(other)
+}
+
+// CopyFull copies into a destination BitSet such that the destination is identical to the source after the operation
+func (b *Bitmap) CopyFull(other *bitset.BitSet) {
+	b.bits.CopyFull(other)
+}
+
+// Count returns the number of set bits
+func (b *Bitmap) Count() uint {
+	return b.bits.Count()
+}
+
+// Equal returns whether the bitmap and the given mask are the same (compares both bits and size)
+func (b *Bitmap) Equal(other Mask) bool {
+	return equal(b, other)
+}
+
+// Difference performs the difference operation with the given BitSet:
+//
+//	other = other & (~Bitmap)
+func (b *Bitmap) Difference(other *bitset.BitSet) {
+	other.InPlaceDifference(b.bits)
+}
+
+// DifferenceCardinality returns the cardinality of the difference operation with the given mask:
+//
+//	count( other & (~Bitmap) )
+func (b *Bitmap) DifferenceCardinality(other Mask) uint {
+	return other.Count() - intersectionCardinality(b, other)
+}
+
+// Intersection performs the intersection operation with the given BitSet:
+//
+//	other = other & Bitmap
+func (b *Bitmap) Intersection(other *bitset.BitSet) {
+	other.InPlaceIntersection(b.bits)
+}
+
+// IntersectionCardinality returns the cardinality of the intersection operation with the given mask:
+//
+//	count( other & Bitmap )
+func (b *Bitmap) IntersectionCardinality(other Mask) uint {
+	return intersectionCardinality(b, other)
+}
+
+// Union performs the union operation with the given BitSet:
+//
+//	other = other | Bitmap
+func (b *Bitmap) Union(other *bitset.BitSet) {
+	other.InPlaceUnion(b.bits)
+}
+
+// UnionCardinality returns the cardinality of the union operation with the given mask:
+//
+//	count( other | Bitmap )
+func (b *Bitmap) UnionCardinality(other Mask) uint {
+	return b.Count() + other.Count() - intersectionCardinality(b, other)
+}
+
+// SymmetricalDifference performs the symmetrical difference operation with the given BitSet:
+//
+//	other = other ^ Bitmap
+func (b *Bitmap) SymmetricalDifference(other *bitset.BitSet) {
+	other.InPlaceSymmetricDifference(b.bits)
+}
+
+// SymmetricalDifferenceCardinality returns the cardinality of the symmetrical difference operation
+// with the given mask:
+//
+//	count( other ^ Bitmap )
+func (b *Bitmap) SymmetricalDifferenceCardinality(other Mask) uint {
+	return b.Count() + other.Count() - 2*intersectionCardinality(b, other)
+}
+
+// All returns true if all bits are set, false otherwise (returns true for empty bitmaps)
+func (b *Bitmap) All() bool {
+	return b.bits.All()
+}
+
+// None returns true if no bit is set, false otherwise (returns true for empty bitmaps)
+func (b *Bitmap) None() bool {
+	return b.bits.None()
+}
+
+// Any returns true if any bit is set, false otherwise
+func (b *Bitmap) Any() bool {
+	return b.bits.Any()
+}
+
+// IsSuperSet returns true if this is a superset of the other set
+func (b *Bitmap) IsSuperSet(other Mask) bool {
+	return isSuperSet(b, other)
+}
+
+// IsStrictSuperSet returns true if this is a strict superset of the other set
+func (b *Bitmap) IsStrictSuperSet(other Mask) bool {
+	return b.Count() > other.Count() && isSuperSet(b, other)
+}
+
+// IsSubSetOf returns true if this is a subset of the other set
+func (b *Bitmap) IsSubSetOf(other Mask) bool {
+	return isSuperSet(other, b)
+}
+
+// IsStrictSubSetOf returns true if this is a strict subset of the other set
+func (b *Bitmap) IsStrictSubSetOf(other Mask) bool {
+	return other.Count() > b.Count() && isSuperSet(other, b)
+}
+
+// Rank returns the number of set bits up to and including the index that are set in the bitmap
+func (b *Bitmap) Rank(index uint) uint {
+	return b.bits.Rank(index)
+}
+
+// Select returns the index of the jth set bit, where j is the argument
+//
+// WARNING: When j is out of range, the function returns the length of the bitmap
+func (b *Bitmap) Select(index uint) uint {
+	return b.bits.Select(index)
+}
+
+// applyRange applies the given function to each word overlapping the range [start, end), along with the mask
+// of the bits of the word inside the range
+//
+// NOTE: The caller is responsible to ensure that start < end <= len(words) * wordSize
+func applyRange(words []uint64, start, end uint, apply func(word, mask uint64) uint64) {
+	first, last := start/wordSize, (end-1)/wordSize
+
+	for index := first; index <= last; index++ {
+		mask := ^uint64(0)
+		if index == first {
+			mask &= ^uint64(0) << (start % wordSize)
+		}
+		if index == last {
+			mask &= ^uint64(0) >> (wordSize - 1 - (end-1)%wordSize)
+		}
+		words[index] = apply(words[index], mask)
+	}
+}
+
+// setMask sets the masked bits of the word
+func setMask(word, mask uint64) uint64 {
+	return word | mask
+}
+
+// clearMask clears the masked bits of the word
+func clearMask(word, mask uint64) uint64 {
+	return word &^ mask
+}
@@ -0,0 +1,156 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/bits-and-blooms/bitset"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	numBits     = 1000
+	maxBitIndex = 5000
+)
+
+var _ Mask = (*Bitmap)(nil)
+
+func TestBitmap_SetClearFlip(t *testing.T) {
+	bitmap := NewBitmap(0)
+
+	bitmap.Set(3)
+	bitmap.Set(130)
+	assert.True(t, bitmap.Test(3))
+	assert.True(t, bitmap.Test(130))
+	assert.Equal(t, uint(2), bitmap.Count())
+
+	bitmap.Clear(3)
+	assert.False(t, bitmap.Test(3))
+
+	bitmap.Flip(3)
+	bitmap.Flip(130)
+	assert.True(t, bitmap.Test(3))
+	assert.False(t, bitmap.Test(130))
+
+	bitmap.Clear(10000)
+	assert.Equal(t, uint(1), bitmap.Count())
+}
+
+func TestBitmap_Ranges(t *testing.T) {
+	bitmap := NewBitmap(0)
+
+	bitmap.SetRange(60, 200)
+	assert.Equal(t, uint(140), bitmap.Count())
+	assert.Equal(t, uint(200), bitmap.Len())
+	assert.False(t, bitmap.Test(59))
+	assert.True(t, bitmap.Test(60))
+	assert.True(t, bitmap.Test(199))
+
+	bitmap.ClearRange(64, 128)
+	assert.Equal(t, uint(76), bitmap.Count())
+	assert.True(t, bitmap.Test(63))
+	assert.False(t, bitmap.Test(64))
+	assert.False(t, bitmap.Test(127))
+	assert.True(t, bitmap.Test(128))
+
+	bitmap.FlipRange(62, 66)
+	assert.False(t, bitmap.Test(62))
+	assert.False(t, bitmap.Test(63))
+	assert.True(t, bitmap.Test(64))
+	assert.True(t, bitmap.Test(65))
+
+	bitmap.ClearRange(0, 1000)
+	assert.True(t, bitmap.None())
+
+	bitmap.SetRange(5, 5)
+	assert.True(t, bitmap.None())
+
+	bitmap.SetRange(3, 4)
+	assert.Equal(t, []uint{3}, slices.Collect(bitmap.Ones()))
+}
+
+func TestBitmap_Ones(t *testing.T) {
+	keys := testutil.RandomUInts(numBits, maxBitIndex)
+	bitmap := BitmapOf(keys...)
+
+	expected := slices.Compact(slices.Sorted(slices.Values(keys)))
+	assert.Equal(t, expected, slices.Collect(bitmap.Ones()))
+
+	for index := range bitmap.Ones() {
+		assert.Equal(t, expected[0], index)
+		break
+	}
+}
+
+func TestBitmap_NonDestructive(t *testing.T) {
+	a := BitmapOf(1, 2, 3, 100)
+	b := BitmapOf(2, 3, 4, 200)
+
+	assert.Equal(t, []uint{1, 2, 3, 4, 100, 200}, slices.Collect(a.Or(b).Ones()))
+	assert.Equal(t, []uint{2, 3}, slices.Collect(a.And(b).Ones()))
+	assert.Equal(t, []uint{1, 100}, slices.Collect(a.AndNot(b).Ones()))
+	assert.Equal(t, []uint{1, 4, 100, 200}, slices.Collect(a.Xor(b).Ones()))
+
+	assert.Equal(t, []uint{1, 2, 3, 100}, slices.Collect(a.Ones()))
+	assert.Equal(t, []uint{2, 3, 4, 200}, slices.Collect(b.Ones()))
+}
+
+func TestBitmap_InPlace(t *testing.T) {
+	b := BitmapOf(2, 3, 4, 200)
+
+	a := BitmapOf(1, 2, 3, 100)
+	a.InPlaceOr(b)
+	assert.Equal(t, []uint{1, 2, 3, 4, 100, 200}, slices.Collect(a.Ones()))
+
+	a = BitmapOf(1, 2, 3, 100)
+	a.InPlaceAnd(b)
+	assert.Equal(t, []uint{2, 3}, slices.Collect(a.Ones()))
+
+	a = BitmapOf(1, 2, 3, 100)
+	a.InPlaceAndNot(b)
+	assert.Equal(t, []uint{1, 100}, slices.Collect(a.Ones()))
+
+	a = BitmapOf(1, 2, 3, 100)
+	a.InPlaceXor(b)
+	assert.Equal(t, []uint{1, 4, 100, 200}, slices.Collect(a.Ones()))
+
+	assert.Equal(t, []uint{2, 3, 4, 200}, slices.Collect(b.Ones()))
+}
+
+func TestBitmap_Mask(t *testing.T) {
+	var mask Mask = BitmapOf(1, 5, 9)
+
+	other := bitset.New(0).Set(5).Set(7)
+	assert.Equal(t, uint(1), mask.IntersectionCardinality(NewMask(other)))
+	assert.Equal(t, uint(4), mask.UnionCardinality(NewMask(other)))
+
+	mask.Union(other)
+	assert.Equal(t, uint(4), other.Count())
+
+	index, found := mask.NextSet(2)
+	assert.True(t, found)
+	assert.Equal(t, uint(5), index)
+	assert.Equal(t, uint(2), mask.Rank(5))
+	assert.Equal(t, uint(9), mask.Select(2))
+	assert.True(t, mask.IsSubSetOf(NewMask(other)))
+}
@@ -48,6 +48,15 @@ func (m *BitMask) Bits() *bitset.BitSet {
 	return m.bitSet
 }
 
+// NextSetMany returns many next bit sets from the specified index, including possibly the current index
+// and up to cap(buffer), along with the last index found (an empty slice means no set bit was found)
+//
+//	buffer := make([]uint, 256)
+//	for i, b := m.NextSetMany(0, buffer); len(b) > 0; i, b = m.NextSetMany(i + 1, b) {...}
+func (m *BitMask) NextSetMany(index uint, buffer []uint) (uint, []uint) {
+	return m.bitSet.NextSetMany(index, buffer)
+}
+
 // Compact shrinks BitSet to so that it preserves all set bits, while minimizing memory usage
 func (m *BitMask) Compact() {
 	m.bitSet = m.bitSet.Compact()
@@ -60,11 +69,11 @@ func (m *BitMask) Difference(other *bitset.BitSet) {
 	other.InPlaceDifference(m.bitSet)
 }
 
-// DifferenceCardinality returns the cardinality of the difference operation with the given BitSet:
+// DifferenceCardinality returns the cardinality of the difference operation with the given mask:
 //
 //	count( other & (~BitMask) )
-func (m *BitMask) DifferenceCardinality(other *bitset.BitSet) uint {
-	return other.DifferenceCardinality(m.bitSet)
+func (m *BitMask) DifferenceCardinality(other Mask) uint {
+	return other.Count() - intersectionCardinality(m, other)
 }
 
 // Intersection performs the intersection operation with the given BitSet:
@@ -74,11 +83,11 @@ func (m *BitMask) Intersection(other *bitset.BitSet) {
 	other.InPlaceIntersection(m.bitSet)
 }
 
-// IntersectionCardinality returns the cardinality of the intersection operation with the given BitSet:
+// IntersectionCardinality returns the cardinality of the intersection operation with the given mask:
 //
 //	count( other & BitMask )
-func (m *BitMask) IntersectionCardinality(other *bitset.BitSet) uint {
-	return other.IntersectionCardinality(m.bitSet)
+func (m *BitMask) IntersectionCardinality(other Mask) uint {
+	return intersectionCardinality(m, other)
 }
 
 // Union performs the union operation with the given BitSet:
@@ -88,11 +97,11 @@ func (m *BitMask) Union(other *bitset.BitSet) {
 	other.InPlaceUnion(m.bitSet)
 }
 
-// UnionCardinality returns the cardinality of the union operation with the given BitSet:
+// UnionCardinality returns the cardinality of the union operation with the given mask:
 //
 //	count( other | BitMask )
-func (m *BitMask) UnionCardinality(other *bitset.BitSet) uint {
-	return other.UnionCardinality(m.bitSet)
+func (m *BitMask) UnionCardinality(other Mask) uint {
+	return m.Count() + other.Count() - intersectionCardinality(m, other)
 }
 
 // SymmetricalDifference performs the symmetrical difference operation with the given BitSet:
@@ -103,19 +112,34 @@ func (m *BitMask) SymmetricalDifference(other *bitset.BitSet) {
 }
 
 // SymmetricalDifferenceCardinality returns the cardinality of the symmetrical difference operation
-// with the given BitSet:
+// with the given mask:
 //
 //	count( other ^ BitMask )
-func (m *BitMask) SymmetricalDifferenceCardinality(other *bitset.BitSet) uint {
-	return other.SymmetricDifferenceCardinality(m.bitSet)
+func (m *BitMask) SymmetricalDifferenceCardinality(other Mask) uint {
+	return m.Count() + other.Count() - 2*intersectionCardinality(m, other)
 }
 
 // IsSubSetOf returns true if this is a subset of the other set
-func (m *BitMask) IsSubSetOf(other *bitset.BitSet) bool {
-	return other.IsSuperSet(m.bitSet)
+func (m *BitMask) IsSubSetOf(other Mask) bool {
+	return isSuperSet(other, m)
 }
 
 // IsStrictSubSetOf returns true if this is a strict subset of the other set
-func (m *BitMask) IsStrictSubSetOf(other *bitset.BitSet) bool {
-	return other.IsStrictSuperSet(m.bitSet)
+func (m *BitMask) IsStrictSubSetOf(other Mask) bool {
+	return other.Count() > m.Count() && isSuperSet(other, m)
+}
+
+// Equal returns whether the mask and the given mask are the same (compares both bits and size)
+func (m *BitMask) Equal(other Mask) bool {
+	return equal(m, other)
+}
+
+// IsSuperSet returns true if this is a superset of the other set
+func (m *BitMask) IsSuperSet(other Mask) bool {
+	return isSuperSet(m, other)
+}
+
+// IsStrictSuperSet returns true if this is a strict superset of the other set
+func (m *BitMask) IsStrictSuperSet(other Mask) bool {
+	return m.Count() > other.Count() && isSuperSet(m, other)
 }
@@ -0,0 +1,453 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"math"
+	"math/bits"
+	"slices"
+	"sort"
+)
+
+const (
+	// containerBits the number of bits covered by a roaring container (the low 16 bits of an index)
+	containerBits = 1 << 16
+
+	// containerWords the number of words of a bitmap container
+	containerWords = containerBits / wordSize
+
+	// arrayMaxSize the maximum cardinality of an array container, after which a bitmap container is smaller
+	arrayMaxSize = 4096
+)
+
+// container represents a chunk of a roaring bitmap, holding the low 16 bits of the indexes sharing the same high bits
+//
+// NOTE: The mutating operations return the container to be used from now on, since a container might change its
+// representation (array <-> bitmap) when its cardinality crosses arrayMaxSize
+type container interface {
+
+	// cardinality returns the number of values in the container
+	cardinality() int
+
+	// has returns whether the value is in the container
+	has(low uint16) bool
+
+	// add adds the value to the container
+	add(low uint16) container
+
+	// remove removes the value from the container
+	remove(low uint16) container
+
+	// next returns the smallest value in the container greater than or equal to low
+	next(low uint16) (uint16, bool)
+
+	// nextAbsent returns the smallest value missing from the container greater than or equal to low
+	nextAbsent(low uint16) (uint16, bool)
+
+	// rank returns the number of values in the container less than or equal to low
+	rank(low uint16) int
+
+	// selectAt returns the jth value of the container (0 <= j < cardinality)
+	selectAt(j int) uint16
+
+	// maximum returns the largest value of the container
+	maximum() uint16
+
+	// words returns the container as containerWords words (scratch is used as storage, unless the container
+	// is already a bitmap)
+	//
+	// WARNING: The returned words must not be modified
+	words(scratch []uint64) []uint64
+
+	// clone returns a deep copy of the container
+	clone() container
+}
+
+// arrayContainer represents a sparse container, as a sorted array of values
+//   - values []uint16 - the sorted values of the container
+type arrayContainer struct {
+	values []uint16
+}
+
+// bitmapContainer represents a dense container, as a bitmap of containerBits bits
+//   - bits []uint64 - the words of the bitmap (containerWords words)
+//   - count int - the number of set bits
+type bitmapContainer struct {
+	bits  []uint64
+	count int
+}
+
+// run represents an interval of consecutive values [start, last]
+//   - start uint16 - the first value of the run
+//   - last uint16 - the last value of the run (inclusive)
+type run struct {
+	start uint16
+	last  uint16
+}
+
+// runContainer represents a container of long intervals, as a sorted list of disjoint runs
+//   - runs []run - the sorted runs of the container
+//
+// NOTE: A run container is only produced by Roaring.RunOptimize and by decoding, and is converted to an array
+// or bitmap container on its first modification
+type runContainer struct {
+	runs []run
+}
+
+func (a *arrayContainer) cardinality() int {
+	return len(a.values)
+}
+
+func (a *arrayContainer) has(low uint16) bool {
+	_, found := slices.BinarySearch(a.values, low)
+	return found
+}
+
+func (a *arrayContainer) add(low uint16) container {
+	index, found := slices.BinarySearch(a.values, low)
+	if found {
+		return a
+	}
+	if len(a.values) == arrayMaxSize {
+		return a.bitmap().add(low)
+	}
+
+	a.values = slices.Insert(a.values, index, low)
+	return a
+}
+
+func (a *arrayContainer) remove(low uint16) container {
+	if index, found := slices.BinarySearch(a.values, low); found {
+		a.values = slices.Delete(a.values, index, index+1)
+	}
+	return a
+}
+
+func (a *arrayContainer) next(low uint16) (uint16, bool) {
+	index, _ := slices.BinarySearch(a.values, low)
+	if index == len(a.values) {
+		return 0, false
+	}
+	return a.values[index], true
+}
+
+func (a *arrayContainer) nextAbsent(low uint16) (uint16, bool) {
+	index, found := slices.BinarySearch(a.values, low)
+	if !found {
+		return low, true
+	}
+
+	for index+1 < len(a.values) && a.values[index+1] == a.values[index]+1 {
+		index++
+	}
+	if a.values[index] == math.MaxUint16 {
+		return 0, false
+	}
+	return a.values[index] + 1, true
+}
+
+func (a *arrayContainer) rank(low uint16) int {
+	index, found := slices.BinarySearch(a.values, low)
+	if found {
+		return index + 1
+	}
+	return index
+}
+
+func (a *arrayContainer) selectAt(j int) uint16 {
+	return a.values[j]
+}
+
+func (a *arrayContainer) maximum() uint16 {
+	return a.values[len(a.values)-1]
+}
+
+func (a *arrayContainer) words(scratch []uint64) []uint64 {
+	clear(scratch)
+	for _, value := range a.values {
+		scratch[value/wordSize] |= 1 << (value % wordSize)
+	}
+	return scratch
+}
+
+func (a *arrayContainer) clone() container {
+	return &arrayContainer{values: slices.Clone(a.values)}
+}
+
+// bitmap converts the array container to a bitmap container
+func (a *arrayContainer) bitmap() *bitmapContainer {
+	b := &bitmapContainer{bits: make([]uint64, containerWords), count: len(a.values)}
+	a.words(b.bits)
+	return b
+}
+
+func (b *bitmapContainer) cardinality() int {
+	return b.count
+}
+
+func (b *bitmapContainer) has(low uint16) bool {
+	return b.bits[low/wordSize]&(1<<(low%wordSize)) != 0
+}
+
+func (b *bitmapContainer) add(low uint16) container {
+	if !b.has(low) {
+		b.bits[low/wordSize] |= 1 << (low % wordSize)
+		b.count++
+	}
+	return b
+}
+
+func (b *bitmapContainer) remove(low uint16) container {
+	if !b.has(low) {
+		return b
+	}
+
+	b.bits[low/wordSize] &^= 1 << (low % wordSize)
+	b.count--
+	if b.count <= arrayMaxSize {
+		return b.array()
+	}
+	return b
+}
+
+func (b *bitmapContainer) next(low uint16) (uint16, bool) {
+	index := int(low / wordSize)
+	word := b.bits[index] >> (low % wordSize)
+	if word != 0 {
+		return low + uint16(bits.TrailingZeros64(word)), true
+	}
+
+	for index++; index < containerWords; index++ {
+		if b.bits[index] != 0 {
+			return uint16(index*wordSize + bits.TrailingZeros64(b.bits[index])), true
+		}
+	}
+	return 0, false
+}
+
+func (b *bitmapContainer) nextAbsent(low uint16) (uint16, bool) {
+	index := int(low / wordSize)
+	word := ^b.bits[index] >> (low % wordSize)
+	if word != 0 {
+		return low + uint16(bits.TrailingZeros64(word)), true
+	}
+
+	for index++; index < containerWords; index++ {
+		if b.bits[index] != math.MaxUint64 {
+			return uint16(index*wordSize + bits.TrailingZeros64(^b.bits[index])), true
+		}
+	}
+	return 0, false
+}
+
+func (b *bitmapContainer) rank(low uint16) int {
+	index := int(low / wordSize)
+	count := bits.OnesCount64(b.bits[index] << (wordSize - 1 - low%wordSize))
+	for _, word := range b.bits[:index] {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+func (b *bitmapContainer) selectAt(j int) uint16 {
+	for index, word := range b.bits {
+		count := bits.OnesCount64(word)
+		if j >= count {
+			j -= count
+			continue
+		}
+
+		for ; j > 0; j-- {
+			word &= word - 1
+		}
+		return uint16(index*wordSize + bits.TrailingZeros64(word))
+	}
+	return 0
+}
+
+func (b *bitmapContainer) maximum() uint16 {
+	for index := containerWords - 1; index >= 0; index-- {
+		if b.bits[index] != 0 {
+			return uint16(index*wordSize + wordSize - 1 - bits.LeadingZeros64(b.bits[index]))
+		}
+	}
+	return 0
+}
+
+func (b *bitmapContainer) words([]uint64) []uint64 {
+	return b.bits
+}
+
+func (b *bitmapContainer) clone() container {
+	return &bitmapContainer{bits: slices.Clone(b.bits), count: b.count}
+}
+
+// array converts the bitmap container to an array container
+func (b *bitmapContainer) array() *arrayContainer {
+	values := make([]uint16, 0, b.count)
+	for index, word := range b.bits {
+		for ; word != 0; word &= word - 1 {
+			values = append(values, uint16(index*wordSize+bits.TrailingZeros64(word)))
+		}
+	}
+	return &arrayContainer{values: values}
+}
+
+func (r *runContainer) cardinality() int {
+	count := 0
+	for _, run := range r.runs {
+		count += int(run.last-run.start) + 1
+	}
+	return count
+}
+
+func (r *runContainer) has(low uint16) bool {
+	index := r.search(low)
+	return index >= 0 && r.runs[index].last >= low
+}
+
+func (r *runContainer) add(low uint16) container {
+	if r.has(low) {
+		return r
+	}
+	return r.convert().add(low)
+}
+
+func (r *runContainer) remove(low uint16) container {
+	if !r.has(low) {
+		return r
+	}
+	return r.convert().remove(low)
+}
+
+func (r *runContainer) next(low uint16) (uint16, bool) {
+	index := r.search(low)
+	if index >= 0 && r.runs[index].last >= low {
+		return low, true
+	}
+	if index+1 < len(r.runs) {
+		return r.runs[index+1].start, true
+	}
+	return 0, false
+}
+
+func (r *runContainer) nextAbsent(low uint16) (uint16, bool) {
+	index := r.search(low)
+	if index < 0 || r.runs[index].last < low {
+		return low, true
+	}
+
+	for index+1 < len(r.runs) && r.runs[index+1].start == r.runs[index].last+1 {
+		index++
+	}
+	if r.runs[index].last == math.MaxUint16 {
+		return 0, false
+	}
+	return r.runs[index].last + 1, true
+}
+
+func (r *runContainer) rank(low uint16) int {
+	count := 0
+	for _, run := range r.runs {
+		if run.start > low {
+			break
+		}
+		count += int(min(run.last, low)-run.start) + 1
+	}
+	return count
+}
+
+func (r *runContainer) selectAt(j int) uint16 {
+	for _, run := range r.runs {
+		length := int(run.last-run.start) + 1
+		if j < length {
+			return run.start + uint16(j)
+		}
+		j -= length
+	}
+	return 0
+}
+
+func (r *runContainer) maximum() uint16 {
+	return r.runs[len(r.runs)-1].last
+}
+
+func (r *runContainer) words(scratch []uint64) []uint64 {
+	clear(scratch)
+	for _, run := range r.runs {
+		applyRange(scratch, uint(run.start), uint(run.last)+1, setMask)
+	}
+	return scratch
+}
+
+func (r *runContainer) clone() container {
+	return &runContainer{runs: slices.Clone(r.runs)}
+}
+
+// search returns the index of the last run starting at or before low (-1 if there is none)
+func (r *runContainer) search(low uint16) int {
+	return sort.Search(len(r.runs), func(index int) bool {
+		return r.runs[index].start > low
+	}) - 1
+}
+
+// convert converts the run container to an array or a bitmap container, depending on its cardinality
+func (r *runContainer) convert() container {
+	array := &arrayContainer{values: make([]uint16, 0, r.cardinality())}
+	for _, run := range r.runs {
+		for value := uint(run.start); value <= uint(run.last); value++ {
+			array.values = append(array.values, uint16(value))
+		}
+	}
+
+	if len(array.values) > arrayMaxSize {
+		return array.bitmap()
+	}
+	return array
+}
+
+// runsOf returns the maximal runs of values of the given container
+func runsOf(c container) []run {
+	var runs []run
+	for start, found := c.next(0); found; start, found = c.next(start) {
+		end, more := c.nextAbsent(start)
+		if !more {
+			return append(runs, run{start: start, last: math.MaxUint16})
+		}
+		runs = append(runs, run{start: start, last: end - 1})
+		start = end
+	}
+	return runs
+}
+
+// optimize returns the smallest representation of the given container (array, bitmap or run container)
+func optimize(c container) container {
+	runs := runsOf(c)
+	count := c.cardinality()
+
+	runSize := 2 + 4*len(runs)
+	if runSize < min(2*count, 8*containerWords) {
+		return &runContainer{runs: runs}
+	}
+	if r, ok := c.(*runContainer); ok {
+		return r.convert()
+	}
+	return c
+}
@@ -0,0 +1,231 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bits-and-blooms/bitset"
+)
+
+// ErrUnknownFlag is returned when encoding or decoding a flag without a registered name
+var ErrUnknownFlag = errors.New("bit: unknown flag")
+
+// flagNames the registered name tables, by enum type (reflect.Type -> *flagTable)
+var flagNames sync.Map
+
+// Enum represents the enum types usable as flags (each value is the index of its bit)
+type Enum interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Flags represents a set of enum values, stored as the bits of a single word
+//   - bits uint64 - the bit of each member (bit e set = e is a member)
+//
+// NOTE: Flags is a value type (the zero value is the empty set), and all operations return new flags
+//
+//	const (
+//		Read Permission = iota
+//		Write
+//		Execute
+//	)
+//
+//	flags := bit.FlagsOf(Read).With(Write) -> {Read, Write}
+//
+// WARNING: Only the enum values in [0, 64) can be members, the caller is responsible to ensure that
+type Flags[E Enum] struct {
+	bits uint64
+}
+
+// flagTable represents the names of the values of an enum type
+//   - names map[uint64]string - the name of each value
+//   - values map[string]uint64 - the value of each name
+type flagTable struct {
+	names  map[uint64]string
+	values map[string]uint64
+}
+
+// RegisterFlags registers the names of the values of the enum type, used by Flags.String and the JSON encoding
+func RegisterFlags[E Enum](names map[E]string) {
+	table := &flagTable{
+		names:  make(map[uint64]string, len(names)),
+		values: make(map[string]uint64, len(names)),
+	}
+	for value, name := range names {
+		table.names[uint64(value)] = name
+		table.values[name] = uint64(value)
+	}
+	flagNames.Store(reflect.TypeFor[E](), table)
+}
+
+// FlagsOf creates new flags with the given members
+func FlagsOf[E Enum](members ...E) Flags[E] {
+	return Flags[E]{}.With(members...)
+}
+
+// FlagsFrom creates new flags with the members set in the given mask
+//
+// NOTE: The bits of the mask outside [0, 64) are ignored
+func FlagsFrom[E Enum](mask Mask) Flags[E] {
+	var flags Flags[E]
+	for index := range Ones(mask) {
+		if index >= wordSize {
+			break
+		}
+		flags.bits |= 1 << index
+	}
+	return flags
+}
+
+// Has returns true if the given value is a member of the flags
+func (f Flags[E]) Has(member E) bool {
+	return f.bits&(1<<uint64(member)) != 0
+}
+
+// With returns new flags with the given members added
+func (f Flags[E]) With(members ...E) Flags[E] {
+	for _, member := range members {
+		f.bits |= 1 << uint64(member)
+	}
+	return f
+}
+
+// Without returns new flags with the given members removed
+func (f Flags[E]) Without(members ...E) Flags[E] {
+	for _, member := range members {
+		f.bits &^= 1 << uint64(member)
+	}
+	return f
+}
+
+// Union returns new flags with the members of both flags
+//
+//	result = f | other
+func (f Flags[E]) Union(other Flags[E]) Flags[E] {
+	return Flags[E]{bits: f.bits | other.bits}
+}
+
+// Intersect returns new flags with the members common to both flags
+//
+//	result = f & other
+func (f Flags[E]) Intersect(other Flags[E]) Flags[E] {
+	return Flags[E]{bits: f.bits & other.bits}
+}
+
+// Count returns the number of members
+func (f Flags[E]) Count() int {
+	return bits.OnesCount64(f.bits)
+}
+
+// Empty returns true if there are no members
+func (f Flags[E]) Empty() bool {
+	return f.bits == 0
+}
+
+// Members returns an iterator over the members, in ascending order
+func (f Flags[E]) Members() iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for word := f.bits; word != 0; word &= word - 1 {
+			if !yield(E(bits.TrailingZeros64(word))) {
+				return
+			}
+		}
+	}
+}
+
+// Mask returns a bit.Mask view over the flags (a bitset of 64 bits)
+func (f Flags[E]) Mask() *BitMask {
+	return NewMask(bitset.From([]uint64{f.bits}))
+}
+
+// String returns a string representation of the flags, using the registered names (the unnamed members
+// are represented by their value)
+//
+//	{Read, Write}
+func (f Flags[E]) String() string {
+	table := lookupFlags[E]()
+
+	var builder strings.Builder
+	builder.WriteByte('{')
+	for member := range f.Members() {
+		if builder.Len() > 1 {
+			builder.WriteString(", ")
+		}
+		if name, ok := table.names[uint64(member)]; ok {
+			builder.WriteString(name)
+		} else {
+			builder.WriteString(strconv.FormatUint(uint64(member), 10))
+		}
+	}
+	builder.WriteByte('}')
+	return builder.String()
+}
+
+// MarshalJSON encodes the flags as a JSON array of the names of the members
+func (f Flags[E]) MarshalJSON() ([]byte, error) {
+	table := lookupFlags[E]()
+
+	names := make([]string, 0, f.Count())
+	for member := range f.Members() {
+		name, ok := table.names[uint64(member)]
+		if !ok {
+			return nil, fmt.Errorf("%w: %d", ErrUnknownFlag, member)
+		}
+		names = append(names, name)
+	}
+	return json.Marshal(names)
+}
+
+// UnmarshalJSON decodes a JSON array of names into the flags, replacing its members
+func (f *Flags[E]) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+
+	table := lookupFlags[E]()
+	var flags Flags[E]
+	for _, name := range names {
+		value, ok := table.values[name]
+		if !ok || value >= wordSize {
+			return fmt.Errorf("%w: %q", ErrUnknownFlag, name)
+		}
+		flags.bits |= 1 << value
+	}
+
+	*f = flags
+	return nil
+}
+
+// lookupFlags returns the name table registered for the enum type (an empty table if none was registered)
+func lookupFlags[E Enum]() *flagTable {
+	if table, ok := flagNames.Load(reflect.TypeFor[E]()); ok {
+		return table.(*flagTable)
+	}
+	return &flagTable{}
+}
@@ -0,0 +1,93 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type permission uint8
+
+const (
+	read permission = iota
+	write
+	execute
+	admin
+)
+
+func init() {
+	RegisterFlags(map[permission]string{
+		read:    "read",
+		write:   "write",
+		execute: "execute",
+	})
+}
+
+func TestFlags(t *testing.T) {
+	var empty Flags[permission]
+	assert.True(t, empty.Empty())
+	assert.Equal(t, "{}", empty.String())
+
+	flags := FlagsOf(read, execute)
+	assert.True(t, flags.Has(read))
+	assert.False(t, flags.Has(write))
+	assert.Equal(t, 2, flags.Count())
+
+	with := flags.With(write, admin)
+	assert.Equal(t, []permission{read, write, execute, admin}, slices.Collect(with.Members()))
+	assert.Equal(t, "{read, write, execute, 3}", with.String())
+	assert.Equal(t, "{read, execute}", flags.String())
+
+	assert.Equal(t, FlagsOf(write, admin), with.Without(read, execute))
+	assert.Equal(t, FlagsOf(read, write, execute), flags.Union(FlagsOf(write)))
+	assert.Equal(t, FlagsOf(execute), flags.Intersect(FlagsOf(write, execute)))
+}
+
+func TestFlags_Mask(t *testing.T) {
+	flags := FlagsOf(write, admin)
+
+	mask := flags.Mask()
+	assert.Equal(t, uint(2), mask.Count())
+	assert.True(t, mask.Test(uint(write)))
+	assert.True(t, mask.Test(uint(admin)))
+
+	assert.Equal(t, flags, FlagsFrom[permission](mask))
+	assert.Equal(t, FlagsOf(read, execute), FlagsFrom[permission](RoaringOf(0, 2, 64, 1000)))
+}
+
+func TestFlags_JSON(t *testing.T) {
+	data, err := json.Marshal(FlagsOf(read, execute))
+	assert.NoError(t, err)
+	assert.Equal(t, `["read","execute"]`, string(data))
+
+	var flags Flags[permission]
+	assert.NoError(t, json.Unmarshal([]byte(`["write","read","write"]`), &flags))
+	assert.Equal(t, FlagsOf(read, write), flags)
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`["root"]`), &flags), ErrUnknownFlag)
+	assert.Equal(t, FlagsOf(read, write), flags)
+
+	_, err = json.Marshal(FlagsOf(admin))
+	assert.ErrorIs(t, err, ErrUnknownFlag)
+}
@@ -0,0 +1,288 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/bits-and-blooms/bitset"
+)
+
+const (
+	// blockWords the number of words of a rank block (512 bits)
+	blockWords = 8
+
+	// selectSample the number of set bits between two select samples
+	selectSample = 512
+)
+
+// Indexed represents a read-only mask with a succinct rank / select index, built once from a BitMask
+//   - bits *bitset.BitSet - the indexed bits (a copy of the bits of the original mask)
+//   - ranks []uint - the number of set bits before each block of blockWords words (+ the total count)
+//   - samples []uint - the block holding every selectSample-th set bit
+//
+// NOTE: Rank is O(1) (one table lookup and at most blockWords popcounts), while Select jumps to the sampled block
+// and binary searches the ranks up to the next sample (near O(1) for all but very sparse masks)
+//
+// NOTE: The indexed bits are private, so only the read-only Mask operations are exposed and the index cannot
+// go stale
+type Indexed struct {
+	bits    *bitset.BitSet
+	ranks   []uint
+	samples []uint
+}
+
+// NewIndexed creates a new index over a copy of the bits of the given mask
+func NewIndexed(mask *BitMask) *Indexed {
+	clone := mask.Bits().Clone()
+	words := clone.Words()
+	blocks := (len(words) + blockWords - 1) / blockWords
+
+	indexed := &Indexed{
+		bits:  clone,
+		ranks: make([]uint, blocks+1),
+	}
+
+	count := uint(0)
+	for block := range blocks {
+		indexed.ranks[block] = count
+		for _, word := range words[block*blockWords : min((block+1)*blockWords, len(words))] {
+			count += uint(bits.OnesCount64(word))
+		}
+		for uint(len(indexed.samples))*selectSample < count {
+			indexed.samples = append(indexed.samples, uint(block))
+		}
+	}
+	indexed.ranks[blocks] = count
+	return indexed
+}
+
+// Count returns the number of set bits
+func (i *Indexed) Count() uint {
+	return i.ranks[len(i.ranks)-1]
+}
+
+// Rank returns the number of set bits up to and including the index that are set in the mask
+func (i *Indexed) Rank(index uint) uint {
+	if index >= i.Len() {
+		return i.Count()
+	}
+
+	words := i.bits.Words()
+	word := index / wordSize
+	count := i.ranks[word/blockWords]
+	for _, w := range words[word/blockWords*blockWords : word] {
+		count += uint(bits.OnesCount64(w))
+	}
+	return count + uint(bits.OnesCount64(words[word]<<(wordSize-1-index%wordSize)))
+}
+
+// Select returns the index of the jth set bit, where j is the argument
+//
+// WARNING: When j is out of range, the function returns the length of the mask
+func (i *Indexed) Select(index uint) uint {
+	if index >= i.Count() {
+		return i.Len()
+	}
+
+	sample := index / selectSample
+	first, last := i.samples[sample], uint(len(i.ranks)-1)
+	if sample+1 < uint(len(i.samples)) {
+		last = i.samples[sample+1] + 1
+	}
+
+	// the last block starting with less than index + 1 set bits before it
+	block := first + uint(sort.Search(int(last-first), func(offset int) bool {
+		return i.ranks[first+uint(offset)] > index
+	})) - 1
+
+	index -= i.ranks[block]
+	words := i.bits.Words()
+	for position := block * blockWords; ; position++ {
+		count := uint(bits.OnesCount64(words[position]))
+		if index < count {
+			return position*wordSize + selectInWord(words[position], index)
+		}
+		index -= count
+	}
+}
+
+// Len returns the number of bits in the mask
+func (i *Indexed) Len() uint {
+	return i.bits.Len()
+}
+
+// Test returns whether the bit at the given index is set
+func (i *Indexed) Test(index uint) bool {
+	return i.bits.Test(index)
+}
+
+// String returns a string representation of the mask
+func (i *Indexed) String() string {
+	return i.bits.String()
+}
+
+// NextSet returns the next bit set from the specified index, including possibly the current index
+// along with an error code (true = valid, false = no set bit found, i.e all bits are clear)
+func (i *Indexed) NextSet(index uint) (uint, bool) {
+	return i.bits.NextSet(index)
+}
+
+// NextClear returns the next bit clear from the specified index, including possibly the current index
+// along with an error code (true = valid, false = no clear bit found, i.e all bits are set)
+func (i *Indexed) NextClear(index uint) (uint, bool) {
+	return i.bits.NextClear(index)
+}
+
+// NextSetMany returns many next bit sets from the specified index, including possibly the current index
+// and up to cap(buffer), along with the last index found (an empty slice means no set bit was found)
+func (i *Indexed) NextSetMany(index uint, buffer []uint) (uint, []uint) {
+	return i.bits.NextSetMany(index, buffer)
+}
+
+// Clone returns a new BitSet with the same bits set and same size
+func (i *Indexed) Clone() *bitset.BitSet {
+	return i.bits.Clone()
+}
+
+// Copy copies bits into a destination BitSet (using the Go array copy semantics)
+func (i *Indexed) Copy(other *bitset.BitSet) uint {
+	return i.bits.Copy(other)
+}
+
+// CopyFull copies into a destination BitSet such that the destination is identical to the source after the operation
+func (i *Indexed) CopyFull(other *bitset.BitSet) {
+	i.bits.CopyFull(other)
+}
+
+// Equal returns whether the mask and the given mask are the same (compares both bits and size)
+func (i *Indexed) Equal(other Mask) bool {
+	return equal(i, other)
+}
+
+// Difference performs the difference operation with the given BitSet:
+//
+//	other = other & (~Indexed)
+func (i *Indexed) Difference(other *bitset.BitSet) {
+	other.InPlaceDifference(i.bits)
+}
+
+// DifferenceCardinality returns the cardinality of the difference operation with the given mask:
+//
+//	count( other & (~Indexed) )
+func (i *Indexed) DifferenceCardinality(other Mask) uint {
+	return other.Count() - intersectionCardinality(i, other)
+}
+
+// Intersection performs the intersection operation with the given BitSet:
+//
+//	other = other & Indexed
+func (i *Indexed) Intersection(other *bitset.BitSet) {
+	other.InPlaceIntersection(i.bits)
+}
+
+// IntersectionCardinality returns the cardinality of the intersection operation with the given mask:
+//
+//	count( other & Indexed )
+func (i *Indexed) IntersectionCardinality(other Mask) uint {
+	return intersectionCardinality(i, other)
+}
+
+// Union performs the union operation with the given BitSet:
+//
+//	other = other | Indexed
+func (i *Indexed) Union(other *bitset.BitSet) {
+	other.InPlaceUnion(i.bits)
+}
+
+// UnionCardinality returns the cardinality of the union operation with the given mask:
+//
+//	count( other | Indexed )
+func (i *Indexed) UnionCardinality(other Mask) uint {
+	return i.Count() + other.Count() - intersectionCardinality(i, other)
+}
+
+// SymmetricalDifference performs the symmetrical difference operation with the given BitSet:
+//
+//	other = other ^ Indexed
+func (i *Indexed) SymmetricalDifference(other *bitset.BitSet) {
+	other.InPlaceSymmetricDifference(i.bits)
+}
+
+// SymmetricalDifferenceCardinality returns the cardinality of the symmetrical difference operation
+// with the given mask:
+//
+//	count( other ^ Indexed )
+func (i *Indexed) SymmetricalDifferenceCardinality(other Mask) uint {
+	return i.Count() + other.Count() - 2*intersectionCardinality(i, other)
+}
+
+// All returns true if all bits are set, false otherwise (returns true for empty masks)
+func (i *Indexed) All() bool {
+	return i.Count() == i.Len()
+}
+
+// None returns true if no bit is set, false otherwise (returns true for empty masks)
+func (i *Indexed) None() bool {
+	return i.Count() == 0
+}
+
+// Any returns true if any bit is set, false otherwise
+func (i *Indexed) Any() bool {
+	return i.Count() > 0
+}
+
+// IsSuperSet returns true if this is a superset of the other set
+func (i *Indexed) IsSuperSet(other Mask) bool {
+	return isSuperSet(i, other)
+}
+
+// IsStrictSuperSet returns true if this is a strict superset of the other set
+func (i *Indexed) IsStrictSuperSet(other Mask) bool {
+	return i.Count() > other.Count() && isSuperSet(i, other)
+}
+
+// IsSubSetOf returns true if this is a subset of the other set
+func (i *Indexed) IsSubSetOf(other Mask) bool {
+	return isSuperSet(other, i)
+}
+
+// IsStrictSubSetOf returns true if this is a strict subset of the other set
+func (i *Indexed) IsStrictSubSetOf(other Mask) bool {
+	return other.Count() > i.Count() && isSuperSet(other, i)
+}
+
+// selectInWord returns the position of the jth set bit of the word
+//
+// NOTE: The caller is responsible to ensure that 0 <= j < OnesCount64(word)
+func selectInWord(word uint64, j uint) uint {
+	offset := uint(0)
+	for count := uint(bits.OnesCount8(uint8(word))); j >= count; count = uint(bits.OnesCount8(uint8(word))) {
+		j -= count
+		word >>= 8
+		offset += 8
+	}
+
+	for ; j > 0; j-- {
+		word &= word - 1
+	}
+	return offset + uint(bits.TrailingZeros64(word))
+}
@@ -0,0 +1,119 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ Mask = (*Indexed)(nil)
+
+// randomBits returns a bitset of the given length, with each bit set with the given probability
+func randomBits(length uint, probability float64) *bitset.BitSet {
+	bits := bitset.New(length)
+	for index := range length {
+		if rand.Float64() < probability {
+			bits.Set(index)
+		}
+	}
+	return bits
+}
+
+func TestIndexed(t *testing.T) {
+	for _, probability := range []float64{0, 0.001, 0.05, 0.5, 1} {
+		bits := randomBits(100_000+uint(rand.IntN(1000)), probability)
+		indexed := NewIndexed(NewMask(bits))
+
+		assert.Equal(t, bits.Count(), indexed.Count())
+		assert.True(t, indexed.Equal(NewMask(bits)))
+
+		for range numBits {
+			index := rand.UintN(bits.Len() + 100)
+			assert.Equal(t, bits.Rank(index), indexed.Rank(index))
+		}
+		for j := range bits.Count() + 2 {
+			assert.Equal(t, bits.Select(j), indexed.Select(j))
+		}
+	}
+}
+
+func TestIndexed_Copy(t *testing.T) {
+	bits := bitset.New(10).Set(3)
+	indexed := NewIndexed(NewMask(bits))
+
+	bits.Set(5)
+	assert.Equal(t, uint(1), indexed.Count())
+	assert.Equal(t, uint(1), indexed.Rank(9))
+	assert.False(t, indexed.Test(5))
+	assert.Equal(t, uint(0), NewIndexed(NewMask(bitset.New(0))).Rank(3))
+}
+
+func TestIndexed_ReadOnly(t *testing.T) {
+	var mask any = NewIndexed(NewMask(bitset.New(10).Set(3)))
+
+	_, ok := mask.(interface{ Set(uint) *bitset.BitSet })
+	assert.False(t, ok)
+	_, ok = mask.(interface{ InPlaceUnion(*bitset.BitSet) })
+	assert.False(t, ok)
+	_, ok = mask.(interface{ Compact() })
+	assert.False(t, ok)
+	_, ok = mask.(backed)
+	assert.False(t, ok)
+
+	indexed := mask.(*Indexed)
+	other := bitset.New(10).Set(5)
+	indexed.Union(other)
+	assert.Equal(t, uint(2), other.Count())
+	assert.Equal(t, uint(1), indexed.Count())
+	assert.Equal(t, uint(3), indexed.Select(0))
+	assert.Equal(t, uint(10), indexed.Select(1))
+}
+
+func BenchmarkIndexed(b *testing.B) {
+	bits := bitset.New(benchmarkBits)
+	words := bits.Words()
+	for index := range words {
+		words[index] = rand.Uint64()
+	}
+	mask := NewMask(bits)
+	indexed := NewIndexed(mask)
+	count := bits.Count()
+
+	for _, bench := range []struct {
+		name string
+		mask Mask
+	}{{"BitMask", mask}, {"Indexed", indexed}} {
+		b.Run(bench.name+"/Rank", func(b *testing.B) {
+			for b.Loop() {
+				bench.mask.Rank(rand.UintN(bits.Len()))
+			}
+		})
+
+		b.Run(bench.name+"/Select", func(b *testing.B) {
+			for b.Loop() {
+				bench.mask.Select(rand.UintN(count))
+			}
+		})
+	}
+}
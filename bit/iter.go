@@ -0,0 +1,80 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// batchSize the number of indexes extracted at once when iterating over a mask
+const batchSize = 256
+
+// Ones returns an iterator over the indexes of the set bits of the mask, in ascending order
+//
+// NOTE: The indexes are extracted in batches (NextSetMany), or word by word for masks backed by bitsets
+func Ones(m Mask) iter.Seq[uint] {
+	if b, ok := bitsOf(m); ok {
+		return BitmapFrom(b).Ones()
+	}
+
+	return func(yield func(uint) bool) {
+		buffer := make([]uint, batchSize)
+		for index, batch := m.NextSetMany(0, buffer); len(batch) > 0; index, batch = m.NextSetMany(index+1, batch) {
+			for _, value := range batch {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Zeros returns an iterator over the indexes of the clear bits of the mask (up to its length), in ascending order
+func Zeros(m Mask) iter.Seq[uint] {
+	if b, ok := bitsOf(m); ok {
+		return func(yield func(uint) bool) {
+			length := b.Len()
+			for index, word := range b.Words() {
+				if uint(index)*wordSize >= length {
+					return
+				}
+
+				word = ^word
+				if uint(index+1)*wordSize > length {
+					word &= 1<<(length%wordSize) - 1
+				}
+				for ; word != 0; word &= word - 1 {
+					if !yield(uint(index*wordSize + bits.TrailingZeros64(word))) {
+						return
+					}
+				}
+			}
+		}
+	}
+
+	return func(yield func(uint) bool) {
+		for index, ok := m.NextClear(0); ok; index, ok = m.NextClear(index + 1) {
+			if !yield(index) {
+				return
+			}
+		}
+	}
+}
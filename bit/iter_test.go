@@ -0,0 +1,125 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/stretchr/testify/assert"
+)
+
+// benchmarkBits the number of bits of the benchmarked masks
+const benchmarkBits = 1 << 20
+
+func TestOnes(t *testing.T) {
+	masks := backings(numBits, maxBitIndex)
+	bits := masks[0].(*BitMask).Bits()
+	expected := bits.AsSlice(make([]uint, bits.Count()))
+
+	for _, mask := range masks {
+		assert.Equal(t, expected, slices.Collect(Ones(mask)))
+
+		for index := range Ones(mask) {
+			assert.Equal(t, expected[0], index)
+			break
+		}
+	}
+}
+
+func TestZeros(t *testing.T) {
+	masks := backings(numBits, maxBitIndex)
+	bits := masks[0].(*BitMask).Bits()
+
+	var expected []uint
+	for index := range bits.Len() {
+		if !bits.Test(index) {
+			expected = append(expected, index)
+		}
+	}
+
+	for _, mask := range masks {
+		assert.Equal(t, expected, slices.Collect(Zeros(mask)))
+	}
+	assert.Empty(t, slices.Collect(Zeros(NewMask(bitset.New(0)))))
+	assert.Equal(t, []uint{0, 1}, slices.Collect(Zeros(NewMask(bitset.New(3).Set(2)))))
+}
+
+func TestNextSetMany(t *testing.T) {
+	for _, size := range []int{1, 7, 256} {
+		for _, mask := range backings(numBits, maxBitIndex) {
+			var actual []uint
+			buffer := make([]uint, size)
+			for index, batch := mask.NextSetMany(0, buffer); len(batch) > 0; index, batch = mask.NextSetMany(index+1, batch) {
+				assert.LessOrEqual(t, len(batch), size)
+				actual = append(actual, batch...)
+			}
+			assert.Equal(t, slices.Collect(Ones(mask)), actual)
+		}
+	}
+
+	roaring := RoaringOf(5, 1<<16, 1<<32-1)
+	index, batch := roaring.NextSetMany(6, make([]uint, 8))
+	assert.Equal(t, []uint{1 << 16, 1<<32 - 1}, batch)
+	assert.Equal(t, uint(1<<32-1), index)
+
+	_, batch = roaring.NextSetMany(1<<32, batch)
+	assert.Empty(t, batch)
+}
+
+func BenchmarkOnes(b *testing.B) {
+	bits, roaring := bitset.New(benchmarkBits), NewRoaring()
+	for index := range uint(benchmarkBits) {
+		if rand.IntN(2) == 0 {
+			bits.Set(index)
+			roaring.Set(uint32(index))
+		}
+	}
+
+	for _, bench := range []struct {
+		name string
+		mask Mask
+	}{{"BitMask", NewMask(bits)}, {"Roaring", roaring}} {
+		name, mask := bench.name, bench.mask
+		b.Run(name+"/NextSet", func(b *testing.B) {
+			for b.Loop() {
+				for index, ok := mask.NextSet(0); ok; index, ok = mask.NextSet(index + 1) {
+				}
+			}
+		})
+
+		b.Run(name+"/NextSetMany", func(b *testing.B) {
+			buffer := make([]uint, batchSize)
+			for b.Loop() {
+				for index, batch := mask.NextSetMany(0, buffer); len(batch) > 0; index, batch = mask.NextSetMany(index+1, batch) {
+				}
+			}
+		})
+
+		b.Run(name+"/Ones", func(b *testing.B) {
+			for b.Loop() {
+				for range Ones(mask) {
+				}
+			}
+		})
+	}
+}
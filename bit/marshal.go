@@ -0,0 +1,244 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+const (
+	// serialCookieNoRunContainer the cookie of the portable roaring format, when there are no run containers
+	serialCookieNoRunContainer = 12346
+
+	// serialCookie the cookie of the portable roaring format, when there are run containers
+	serialCookie = 12347
+
+	// noOffsetThreshold the number of containers from which the offset header is written (with run containers)
+	noOffsetThreshold = 4
+)
+
+// ErrInvalidData is returned when decoding malformed data
+var ErrInvalidData = errors.New("bit: invalid data")
+
+// MarshalBinary encodes the roaring bitmap in the portable roaring format, compatible with the other
+// roaring implementations (https://github.com/RoaringBitmap/RoaringFormatSpec)
+//
+//	[cookie][run flags][key, cardinality - 1 for each container][offsets][containers]
+func (r *Roaring) MarshalBinary() ([]byte, error) {
+	count := len(r.containers)
+	runs := false
+	for _, c := range r.containers {
+		if _, ok := c.(*runContainer); ok {
+			runs = true
+			break
+		}
+	}
+
+	var data []byte
+	if runs {
+		data = binary.LittleEndian.AppendUint32(data, serialCookie|uint32(count-1)<<16)
+		flags := make([]byte, (count+7)/8)
+		for index, c := range r.containers {
+			if _, ok := c.(*runContainer); ok {
+				flags[index/8] |= 1 << (index % 8)
+			}
+		}
+		data = append(data, flags...)
+	} else {
+		data = binary.LittleEndian.AppendUint32(data, serialCookieNoRunContainer)
+		data = binary.LittleEndian.AppendUint32(data, uint32(count))
+	}
+
+	for index, c := range r.containers {
+		data = binary.LittleEndian.AppendUint16(data, r.keys[index])
+		data = binary.LittleEndian.AppendUint16(data, uint16(c.cardinality()-1))
+	}
+
+	offsets := len(data)
+	if !runs || count >= noOffsetThreshold {
+		data = append(data, make([]byte, 4*count)...)
+	}
+
+	scratch := make([]uint64, containerWords)
+	for index, c := range r.containers {
+		if !runs || count >= noOffsetThreshold {
+			binary.LittleEndian.PutUint32(data[offsets+4*index:], uint32(len(data)))
+		}
+
+		switch c := c.(type) {
+		case *runContainer:
+			data = binary.LittleEndian.AppendUint16(data, uint16(len(c.runs)))
+			for _, run := range c.runs {
+				data = binary.LittleEndian.AppendUint16(data, run.start)
+				data = binary.LittleEndian.AppendUint16(data, run.last-run.start)
+			}
+		case *arrayContainer:
+			for _, value := range c.values {
+				data = binary.LittleEndian.AppendUint16(data, value)
+			}
+		default:
+			for _, word := range c.words(scratch) {
+				data = binary.LittleEndian.AppendUint64(data, word)
+			}
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes the roaring bitmap from the portable roaring format, replacing its contents
+func (r *Roaring) UnmarshalBinary(data []byte) error {
+	decoder := roaringDecoder{data: data}
+
+	cookie := decoder.uint32()
+	var count int
+	var flags []byte
+	switch {
+	case cookie == serialCookieNoRunContainer:
+		count = int(decoder.uint32())
+	case cookie&0xFFFF == serialCookie:
+		count = int(cookie>>16) + 1
+		flags = decoder.bytes((count + 7) / 8)
+	default:
+		return ErrInvalidData
+	}
+	if decoder.failed || count > containerBits {
+		return ErrInvalidData
+	}
+
+	keys := make([]uint16, count)
+	cardinalities := make([]int, count)
+	for index := range count {
+		keys[index] = decoder.uint16()
+		cardinalities[index] = int(decoder.uint16()) + 1
+		if index > 0 && keys[index] <= keys[index-1] {
+			return ErrInvalidData
+		}
+	}
+	if flags == nil || count >= noOffsetThreshold {
+		decoder.bytes(4 * count)
+	}
+
+	containers := make([]container, count)
+	for index := range count {
+		var c container
+		switch {
+		case flags != nil && flags[index/8]&(1<<(index%8)) != 0:
+			c = decoder.run()
+		case cardinalities[index] <= arrayMaxSize:
+			c = decoder.array(cardinalities[index])
+		default:
+			c = decoder.bitmap()
+		}
+		if decoder.failed || c.cardinality() != cardinalities[index] {
+			return ErrInvalidData
+		}
+		containers[index] = c
+	}
+
+	r.keys = keys
+	r.containers = containers
+	return nil
+}
+
+// roaringDecoder represents a little endian reader over the portable roaring format
+//   - data []byte - the remaining data to be read
+//   - failed bool - whether a read went past the end of the data, or the data was malformed
+type roaringDecoder struct {
+	data   []byte
+	failed bool
+}
+
+// bytes reads the next n bytes (nil when there are not enough bytes left)
+func (d *roaringDecoder) bytes(n int) []byte {
+	if d.failed || len(d.data) < n {
+		d.failed = true
+		return nil
+	}
+
+	data := d.data[:n]
+	d.data = d.data[n:]
+	return data
+}
+
+// uint16 reads the next uint16
+func (d *roaringDecoder) uint16() uint16 {
+	if data := d.bytes(2); data != nil {
+		return binary.LittleEndian.Uint16(data)
+	}
+	return 0
+}
+
+// uint32 reads the next uint32
+func (d *roaringDecoder) uint32() uint32 {
+	if data := d.bytes(4); data != nil {
+		return binary.LittleEndian.Uint32(data)
+	}
+	return 0
+}
+
+// array reads an array container of the given cardinality
+func (d *roaringDecoder) array(cardinality int) container {
+	array := &arrayContainer{values: make([]uint16, 0, cardinality)}
+	for range cardinality {
+		value := d.uint16()
+		if len(array.values) > 0 && value <= array.values[len(array.values)-1] {
+			d.failed = true
+		}
+		array.values = append(array.values, value)
+	}
+	return array
+}
+
+// bitmap reads a bitmap container
+func (d *roaringDecoder) bitmap() container {
+	bitmap := &bitmapContainer{bits: make([]uint64, containerWords)}
+	data := d.bytes(8 * containerWords)
+	if data == nil {
+		return bitmap
+	}
+
+	for index := range bitmap.bits {
+		bitmap.bits[index] = binary.LittleEndian.Uint64(data[8*index:])
+		bitmap.count += bits.OnesCount64(bitmap.bits[index])
+	}
+	return bitmap
+}
+
+// run reads a run container
+func (d *roaringDecoder) run() container {
+	count := int(d.uint16())
+	runs := &runContainer{runs: make([]run, 0, count)}
+	for range count {
+		start, length := d.uint16(), d.uint16()
+		if uint32(start)+uint32(length) > 0xFFFF {
+			d.failed = true
+		}
+		if len(runs.runs) > 0 && start <= runs.runs[len(runs.runs)-1].last {
+			d.failed = true
+		}
+		runs.runs = append(runs.runs, run{start: start, last: start + length})
+	}
+	if count == 0 {
+		d.failed = true
+	}
+	return runs
+}
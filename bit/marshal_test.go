@@ -0,0 +1,75 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoaring_MarshalBinary(t *testing.T) {
+	data, err := RoaringOf(1, 2).MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{
+		0x3a, 0x30, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x01, 0x00,
+		0x10, 0x00, 0x00, 0x00,
+		0x01, 0x00, 0x02, 0x00,
+	}, data)
+
+	roaring := RoaringOf(0, 1, 2, 3, 4)
+	roaring.RunOptimize()
+	data, err = roaring.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{
+		0x3b, 0x30, 0x00, 0x00, 0x01,
+		0x00, 0x00, 0x04, 0x00,
+		0x01, 0x00, 0x00, 0x00, 0x04, 0x00,
+	}, data)
+}
+
+func TestRoaring_UnmarshalBinary(t *testing.T) {
+	for _, optimize := range []bool{false, true} {
+		roaring, bits := randomRoaring()
+		if optimize {
+			roaring.RunOptimize()
+		}
+
+		data, err := roaring.MarshalBinary()
+		assert.NoError(t, err)
+
+		decoded := NewRoaring()
+		assert.NoError(t, decoded.UnmarshalBinary(data))
+		assert.True(t, decoded.Equal(NewMask(bits)))
+		assert.Equal(t, roaring.String(), decoded.String())
+
+		assert.ErrorIs(t, decoded.UnmarshalBinary(data[:len(data)-1]), ErrInvalidData)
+	}
+
+	empty := NewRoaring()
+	data, err := empty.MarshalBinary()
+	assert.NoError(t, err)
+	assert.NoError(t, empty.UnmarshalBinary(data))
+	assert.True(t, empty.None())
+
+	assert.ErrorIs(t, empty.UnmarshalBinary(nil), ErrInvalidData)
+	assert.ErrorIs(t, empty.UnmarshalBinary([]byte{0, 0, 0, 0}), ErrInvalidData)
+}
@@ -21,6 +21,10 @@ package bit
 
 import "github.com/bits-and-blooms/bitset"
 
+// Mask represents a read-only set of bits
+//
+// NOTE: The read-only operations accept any mask as the other operand (with fast paths when both masks are backed
+// by bitsets), while the operations with effects write into the given bitset (parameter - other)
 type Mask interface {
 
 	// Len - returns the number of bits in the BitSet
@@ -44,6 +48,13 @@ type Mask interface {
 	//	for i,e := v.NextClear(0); e; i,e = v.NextClear(i + 1) {...}
 	NextClear(index uint) (uint, bool)
 
+	// NextSetMany - returns many next bit sets from the specified index, including possibly the current index
+	// and up to cap(buffer), along with the last index found (an empty slice means no set bit was found)
+	//
+	//	buffer := make([]uint, 256)
+	//	for i, b := v.NextSetMany(0, buffer); len(b) > 0; i, b = v.NextSetMany(i + 1, b) {...}
+	NextSetMany(index uint, buffer []uint) (uint, []uint)
+
 	// Clone - returns a new BitSet with the same bits set and same size
 	Clone() *bitset.BitSet
 
@@ -59,38 +70,38 @@ type Mask interface {
 	// Count - returns the number of set bits
 	Count() uint
 
-	// Equal - returns whether the two BitSets are the same (compares both bits and size)
-	Equal(other *bitset.BitSet) bool
+	// Equal - returns whether the two masks are the same (compares both bits and size)
+	Equal(other Mask) bool
 
 	// Difference - performs the difference operation with the given BitSet:
 	//
 	//	other = other & (~BitMask)
 	Difference(other *bitset.BitSet)
 
-	// DifferenceCardinality - returns the cardinality of the difference operation with the given BitSet:
+	// DifferenceCardinality - returns the cardinality of the difference operation with the given mask:
 	//
 	//	count( other & (~BitMask) )
-	DifferenceCardinality(other *bitset.BitSet) uint
+	DifferenceCardinality(other Mask) uint
 
 	// Intersection - performs the intersection operation with the given BitSet:
 	//
 	//	other = other & BitMask
 	Intersection(other *bitset.BitSet)
 
-	// IntersectionCardinality - returns the cardinality of the intersection operation with the given BitSet:
+	// IntersectionCardinality - returns the cardinality of the intersection operation with the given mask:
 	//
 	//	count( other & BitMask )
-	IntersectionCardinality(other *bitset.BitSet) uint
+	IntersectionCardinality(other Mask) uint
 
 	// Union - performs the union operation with the given BitSet:
 	//
 	//	other = other | BitMask
 	Union(other *bitset.BitSet)
 
-	// UnionCardinality - returns the cardinality of the union operation with the given BitSet:
+	// UnionCardinality - returns the cardinality of the union operation with the given mask:
 	//
 	//	count( other | BitMask )
-	UnionCardinality(other *bitset.BitSet) uint
+	UnionCardinality(other Mask) uint
 
 	// SymmetricalDifference - performs the symmetrical difference operation with the given BitSet:
 	//
@@ -98,10 +109,10 @@ type Mask interface {
 	SymmetricalDifference(other *bitset.BitSet)
 
 	// SymmetricalDifferenceCardinality - returns the cardinality of the symmetrical difference operation
-	// with the given BitSet:
+	// with the given mask:
 	//
 	//	count( other ^ BitMask )
-	SymmetricalDifferenceCardinality(other *bitset.BitSet) uint
+	SymmetricalDifferenceCardinality(other Mask) uint
 
 	// All - returns true if all bits are set, false otherwise (returns true for empty sets)
 	All() bool
@@ -113,16 +124,16 @@ type Mask interface {
 	Any() bool
 
 	// IsSuperSet - returns true if this is a superset of the other set
-	IsSuperSet(other *bitset.BitSet) bool
+	IsSuperSet(other Mask) bool
 
 	// IsStrictSuperSet - returns true if this is a strict superset of the other set
-	IsStrictSuperSet(other *bitset.BitSet) bool
+	IsStrictSuperSet(other Mask) bool
 
 	// IsSubSetOf - returns true if this is a subset of the other set
-	IsSubSetOf(other *bitset.BitSet) bool
+	IsSubSetOf(other Mask) bool
 
 	// IsStrictSubSetOf - returns true if this is a strict subset of the other set
-	IsStrictSubSetOf(other *bitset.BitSet) bool
+	IsStrictSubSetOf(other Mask) bool
 
 	// Rank - returns the number of set bits up to and including the index that are set in the bitset
 	Rank(index uint) uint
@@ -0,0 +1,217 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"errors"
+	"iter"
+	"math/bits"
+	"slices"
+
+	"github.com/bits-and-blooms/bitset"
+)
+
+// ErrDimensions is returned when combining matrices of incompatible dimensions
+var ErrDimensions = errors.New("bit: incompatible matrix dimensions")
+
+// Matrix represents a boolean matrix, stored row by row in contiguous words
+//   - words []uint64 - the words of all rows (row i occupies words[i * stride : (i + 1) * stride])
+//   - rows uint - the number of rows
+//   - cols uint - the number of columns
+//   - stride uint - the number of words of a row
+//
+// NOTE: As an adjacency matrix, bit (i, j) is an edge i -> j, so Closure gives the reachability of a graph
+type Matrix struct {
+	words  []uint64
+	rows   uint
+	cols   uint
+	stride uint
+}
+
+// NewMatrix creates a new matrix of the given dimensions (all bits clear)
+func NewMatrix(rows, cols uint) *Matrix {
+	stride := (cols + wordSize - 1) / wordSize
+	return &Matrix{
+		words:  make([]uint64, rows*stride),
+		rows:   rows,
+		cols:   cols,
+		stride: stride,
+	}
+}
+
+// Rows returns the number of rows
+func (m *Matrix) Rows() uint {
+	return m.rows
+}
+
+// Cols returns the number of columns
+func (m *Matrix) Cols() uint {
+	return m.cols
+}
+
+// Set sets the bit at the given row and column
+//
+// NOTE: The caller is responsible to ensure that row < Rows() and col < Cols()
+func (m *Matrix) Set(row, col uint) {
+	m.words[row*m.stride+col/wordSize] |= 1 << (col % wordSize)
+}
+
+// Clear clears the bit at the given row and column
+//
+// NOTE: The caller is responsible to ensure that row < Rows() and col < Cols()
+func (m *Matrix) Clear(row, col uint) {
+	m.words[row*m.stride+col/wordSize] &^= 1 << (col % wordSize)
+}
+
+// Test returns whether the bit at the given row and column is set (false when out of bounds)
+func (m *Matrix) Test(row, col uint) bool {
+	if row >= m.rows || col >= m.cols {
+		return false
+	}
+	return m.words[row*m.stride+col/wordSize]&(1<<(col%wordSize)) != 0
+}
+
+// Count returns the number of set bits
+func (m *Matrix) Count() uint {
+	count := 0
+	for _, word := range m.words {
+		count += bits.OnesCount64(word)
+	}
+	return uint(count)
+}
+
+// Row returns a bit.Mask view over the given row (a bitset of Cols() bits)
+//
+// WARNING: The view shares the words of the matrix, so it reflects (and applies) later changes of the row
+func (m *Matrix) Row(row uint) *BitMask {
+	return NewMask(bitset.FromWithLength(m.cols, m.row(row)))
+}
+
+// Transpose returns a new matrix with the rows and columns swapped
+func (m *Matrix) Transpose() *Matrix {
+	transposed := NewMatrix(m.cols, m.rows)
+	for row := range m.rows {
+		for index, word := range m.row(row) {
+			for ; word != 0; word &= word - 1 {
+				transposed.Set(uint(index)*wordSize+uint(bits.TrailingZeros64(word)), row)
+			}
+		}
+	}
+	return transposed
+}
+
+// Multiply returns the boolean product of the matrix and the given matrix
+//
+//	result(i, j) = OR( m(i, k) AND other(k, j) ), for each k
+//
+// NOTE: The number of columns of the matrix must match the number of rows of the given matrix
+func (m *Matrix) Multiply(other *Matrix) (*Matrix, error) {
+	if m.cols != other.rows {
+		return nil, ErrDimensions
+	}
+
+	product := NewMatrix(m.rows, other.cols)
+	for row := range m.rows {
+		target := product.row(row)
+		for index, word := range m.row(row) {
+			for ; word != 0; word &= word - 1 {
+				orWords(target, other.row(uint(index)*wordSize+uint(bits.TrailingZeros64(word))))
+			}
+		}
+	}
+	return product, nil
+}
+
+// Closure returns the transitive closure of the matrix (Warshall's algorithm)
+//
+//	result(i, j) = true, if there is a path i -> ... -> j
+//
+// NOTE: The matrix must be square
+func (m *Matrix) Closure() (*Matrix, error) {
+	if m.rows != m.cols {
+		return nil, ErrDimensions
+	}
+
+	closure := m.Clone()
+	for k := range closure.rows {
+		source := closure.row(k)
+		for row := range closure.rows {
+			if closure.Test(row, k) {
+				orWords(closure.row(row), source)
+			}
+		}
+	}
+	return closure, nil
+}
+
+// RowsIntersecting returns an iterator over the rows having at least one bit in common with the given mask
+//
+// NOTE: The bits of the mask outside [0, Cols()) are ignored
+func (m *Matrix) RowsIntersecting(mask Mask) iter.Seq[uint] {
+	return func(yield func(uint) bool) {
+		filter := make([]uint64, m.stride)
+		if b, ok := bitsOf(mask); ok {
+			copy(filter, b.Words())
+		} else {
+			for index := range Ones(mask) {
+				if index >= m.cols {
+					break
+				}
+				filter[index/wordSize] |= 1 << (index % wordSize)
+			}
+		}
+
+		for row := range m.rows {
+			for index, word := range m.row(row) {
+				if word&filter[index] != 0 {
+					if !yield(row) {
+						return
+					}
+					break
+				}
+			}
+		}
+	}
+}
+
+// Clone returns a deep copy of the matrix
+func (m *Matrix) Clone() *Matrix {
+	return &Matrix{
+		words:  slices.Clone(m.words),
+		rows:   m.rows,
+		cols:   m.cols,
+		stride: m.stride,
+	}
+}
+
+// row returns the words of the given row (capped, so appending never overwrites the next row)
+func (m *Matrix) row(row uint) []uint64 {
+	start, end := row*m.stride, (row+1)*m.stride
+	return m.words[start:end:end]
+}
+
+// orWords performs the union of the source words into the target words
+//
+//	target = target | source
+func orWords(target, source []uint64) {
+	for index := range target {
+		target[index] |= source[index]
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/stretchr/testify/assert"
+)
+
+// randomMatrix returns a matrix of the given dimensions, with each bit set with the given probability
+func randomMatrix(rows, cols uint, probability float64) *Matrix {
+	matrix := NewMatrix(rows, cols)
+	for row := range rows {
+		for col := range cols {
+			if rand.Float64() < probability {
+				matrix.Set(row, col)
+			}
+		}
+	}
+	return matrix
+}
+
+func TestMatrix_SetTest(t *testing.T) {
+	matrix := NewMatrix(3, 130)
+	matrix.Set(0, 0)
+	matrix.Set(1, 129)
+	matrix.Set(2, 64)
+
+	assert.True(t, matrix.Test(0, 0))
+	assert.True(t, matrix.Test(1, 129))
+	assert.False(t, matrix.Test(1, 128))
+	assert.False(t, matrix.Test(3, 0))
+	assert.False(t, matrix.Test(0, 130))
+	assert.Equal(t, uint(3), matrix.Count())
+
+	matrix.Clear(2, 64)
+	assert.False(t, matrix.Test(2, 64))
+
+	row := matrix.Row(1)
+	assert.Equal(t, uint(130), row.Len())
+	assert.Equal(t, "{129}", row.String())
+
+	matrix.Set(1, 5)
+	assert.Equal(t, "{5,129}", row.String())
+	assert.Equal(t, "{0}", matrix.Row(0).String())
+}
+
+func TestMatrix_Transpose(t *testing.T) {
+	matrix := randomMatrix(70, 150, 0.1)
+	transposed := matrix.Transpose()
+
+	assert.Equal(t, uint(150), transposed.Rows())
+	assert.Equal(t, uint(70), transposed.Cols())
+	for row := range matrix.Rows() {
+		for col := range matrix.Cols() {
+			assert.Equal(t, matrix.Test(row, col), transposed.Test(col, row))
+		}
+	}
+}
+
+func TestMatrix_Multiply(t *testing.T) {
+	a, b := randomMatrix(20, 90, 0.05), randomMatrix(90, 70, 0.05)
+
+	product, err := a.Multiply(b)
+	assert.NoError(t, err)
+	for row := range a.Rows() {
+		for col := range b.Cols() {
+			expected := false
+			for k := range a.Cols() {
+				expected = expected || a.Test(row, k) && b.Test(k, col)
+			}
+			assert.Equal(t, expected, product.Test(row, col))
+		}
+	}
+
+	_, err = a.Multiply(a)
+	assert.ErrorIs(t, err, ErrDimensions)
+}
+
+func TestMatrix_Closure(t *testing.T) {
+	graph := NewMatrix(5, 5)
+	graph.Set(0, 1)
+	graph.Set(1, 2)
+	graph.Set(2, 0)
+	graph.Set(3, 4)
+
+	closure, err := graph.Closure()
+	assert.NoError(t, err)
+	for _, row := range []uint{0, 1, 2} {
+		assert.Equal(t, "{0,1,2}", closure.Row(row).String())
+	}
+	assert.Equal(t, "{4}", closure.Row(3).String())
+	assert.Equal(t, "{}", closure.Row(4).String())
+	assert.Equal(t, uint(4), graph.Count())
+
+	random := randomMatrix(40, 40, 0.03)
+	closure, err = random.Closure()
+	assert.NoError(t, err)
+
+	reach := random.Clone()
+	for range random.Rows() {
+		reach, _ = reach.Multiply(random)
+		for index, word := range random.words {
+			reach.words[index] |= word
+		}
+	}
+	assert.Equal(t, reach.words, closure.words)
+
+	_, err = NewMatrix(2, 3).Closure()
+	assert.ErrorIs(t, err, ErrDimensions)
+}
+
+func TestMatrix_RowsIntersecting(t *testing.T) {
+	matrix := NewMatrix(4, 100)
+	matrix.Set(0, 3)
+	matrix.Set(1, 70)
+	matrix.Set(2, 3)
+	matrix.Set(2, 99)
+
+	mask := bitset.New(0).Set(3).Set(99).Set(500)
+	assert.Equal(t, []uint{0, 2}, slices.Collect(matrix.RowsIntersecting(NewMask(mask))))
+	assert.Equal(t, []uint{1, 2}, slices.Collect(matrix.RowsIntersecting(RoaringOf(70, 99, 500))))
+	assert.Empty(t, slices.Collect(matrix.RowsIntersecting(RoaringOf())))
+}
@@ -0,0 +1,167 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import "github.com/bits-and-blooms/bitset"
+
+// backed represents a mask backed by a bitset, whose words can be used directly (fast path)
+type backed interface {
+	Bits() *bitset.BitSet
+}
+
+// And computes the intersection of the given masks into the destination bitset, and returns the destination
+//
+//	dst = a & b
+//
+// NOTE: The destination may be the bitset backing one of the masks
+func And(dst *bitset.BitSet, a, b Mask) *bitset.BitSet {
+	if isBacking(b, dst) {
+		a, b = b, a
+	}
+	copyInto(dst, a)
+	b.Intersection(dst)
+	return dst
+}
+
+// Or computes the union of the given masks into the destination bitset, and returns the destination
+//
+//	dst = a | b
+//
+// NOTE: The destination may be the bitset backing one of the masks
+func Or(dst *bitset.BitSet, a, b Mask) *bitset.BitSet {
+	if isBacking(b, dst) {
+		a, b = b, a
+	}
+	copyInto(dst, a)
+	b.Union(dst)
+	return dst
+}
+
+// AndNot computes the difference of the given masks into the destination bitset, and returns the destination
+//
+//	dst = a & (~b)
+//
+// NOTE: The destination may be the bitset backing one of the masks
+func AndNot(dst *bitset.BitSet, a, b Mask) *bitset.BitSet {
+	if isBacking(b, dst) {
+		b = NewMask(b.Clone())
+	}
+	copyInto(dst, a)
+	b.Difference(dst)
+	return dst
+}
+
+// Xor computes the symmetrical difference of the given masks into the destination bitset, and returns
+// the destination
+//
+//	dst = a ^ b
+//
+// NOTE: The destination may be the bitset backing one of the masks
+func Xor(dst *bitset.BitSet, a, b Mask) *bitset.BitSet {
+	if isBacking(b, dst) {
+		a, b = b, a
+	}
+	copyInto(dst, a)
+	b.SymmetricalDifference(dst)
+	return dst
+}
+
+// bitsOf returns the bitset backing the given mask, if any
+//
+// NOTE: The bitset of an Indexed mask is returned for the read-only fast paths, and must never be modified
+func bitsOf(m Mask) (*bitset.BitSet, bool) {
+	switch b := m.(type) {
+	case backed:
+		return b.Bits(), true
+	case *Indexed:
+		return b.bits, true
+	}
+	return nil, false
+}
+
+// isBacking returns true if the given bitset is the one backing the mask
+func isBacking(m Mask, bits *bitset.BitSet) bool {
+	b, ok := bitsOf(m)
+	return ok && b == bits
+}
+
+// copyInto copies the mask into the destination bitset (skipped when the destination already backs the mask)
+func copyInto(dst *bitset.BitSet, m Mask) {
+	if !isBacking(m, dst) {
+		m.CopyFull(dst)
+	}
+}
+
+// equal returns true if the masks have the same bits set and the same size
+func equal(a, b Mask) bool {
+	if x, ok := bitsOf(a); ok {
+		if y, ok := bitsOf(b); ok {
+			return x.Equal(y)
+		}
+	}
+
+	count := a.Count()
+	return a.Len() == b.Len() && count == b.Count() && count == intersectionCardinality(a, b)
+}
+
+// isSuperSet returns true if all the bits set in b are also set in a
+func isSuperSet(a, b Mask) bool {
+	if x, ok := bitsOf(a); ok {
+		if y, ok := bitsOf(b); ok {
+			return x.IsSuperSet(y)
+		}
+	}
+	return intersectionCardinality(a, b) == b.Count()
+}
+
+// intersectionCardinality returns the cardinality of the intersection of the given masks, using the word-wise
+// fast paths when both masks are backed by bitsets or roaring bitmaps
+//
+//	count( a & b )
+func intersectionCardinality(a, b Mask) uint {
+	x, xBacked := bitsOf(a)
+	y, yBacked := bitsOf(b)
+	if xBacked && yBacked {
+		return x.IntersectionCardinality(y)
+	}
+
+	r, rOk := a.(*Roaring)
+	s, sOk := b.(*Roaring)
+	switch {
+	case rOk && sOk:
+		return r.intersectRoaring(s)
+	case rOk && yBacked:
+		return r.intersectBits(y)
+	case sOk && xBacked:
+		return s.intersectBits(x)
+	}
+
+	if a.Count() > b.Count() {
+		a, b = b, a
+	}
+
+	count := uint(0)
+	for index, ok := a.NextSet(0); ok; index, ok = a.NextSet(index + 1) {
+		if b.Test(index) {
+			count++
+		}
+	}
+	return count
+}
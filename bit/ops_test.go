@@ -0,0 +1,93 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/stretchr/testify/assert"
+)
+
+// backings returns the same random bits behind each kind of mask
+func backings(count int, limit uint) []Mask {
+	bits, roaring := bitset.New(0), NewRoaring()
+	for range count {
+		index := rand.UintN(limit)
+		bits.Set(index)
+		roaring.Set(uint32(index))
+	}
+	return []Mask{NewMask(bits), BitmapFrom(bits.Clone()), roaring}
+}
+
+func TestOps(t *testing.T) {
+	left, right := backings(numBits, maxBitIndex), backings(numBits, maxBitIndex)
+	x, _ := bitsOf(left[0])
+	y, _ := bitsOf(right[0])
+
+	for _, a := range left {
+		for _, b := range right {
+			assert.Equal(t, x.Intersection(y).String(), And(bitset.New(0), a, b).String())
+			assert.Equal(t, x.Union(y).String(), Or(bitset.New(0), a, b).String())
+			assert.Equal(t, x.Difference(y).String(), AndNot(bitset.New(0), a, b).String())
+			assert.Equal(t, x.SymmetricDifference(y).String(), Xor(bitset.New(0), a, b).String())
+
+			assert.Equal(t, x.IntersectionCardinality(y), a.IntersectionCardinality(b))
+			assert.Equal(t, x.UnionCardinality(y), a.UnionCardinality(b))
+			assert.Equal(t, y.DifferenceCardinality(x), a.DifferenceCardinality(b))
+			assert.Equal(t, x.SymmetricDifferenceCardinality(y), a.SymmetricalDifferenceCardinality(b))
+			assert.False(t, a.IsSuperSet(b))
+			assert.False(t, a.IsSubSetOf(b))
+		}
+
+		for _, b := range left {
+			assert.True(t, a.Equal(b))
+			assert.True(t, a.IsSuperSet(b))
+			assert.True(t, a.IsSubSetOf(b))
+			assert.False(t, a.IsStrictSuperSet(b))
+			assert.False(t, a.IsStrictSubSetOf(b))
+		}
+	}
+}
+
+func TestOps_Aliasing(t *testing.T) {
+	a, b := bitset.New(0).Set(1).Set(2), bitset.New(0).Set(2).Set(3)
+
+	dst := a.Clone()
+	And(dst, NewMask(b), NewMask(dst))
+	assert.Equal(t, "{2}", dst.String())
+
+	dst = b.Clone()
+	AndNot(dst, NewMask(a), NewMask(dst))
+	assert.Equal(t, "{1}", dst.String())
+
+	dst = a.Clone()
+	AndNot(dst, NewMask(dst), NewMask(b))
+	assert.Equal(t, "{1}", dst.String())
+
+	dst = a.Clone()
+	Xor(dst, RoaringOf(2, 3), NewMask(dst))
+	assert.Equal(t, "{1,3}", dst.String())
+
+	dst = a.Clone()
+	Or(dst, NewMask(dst), NewMask(dst))
+	assert.Equal(t, "{1,2}", dst.String())
+}
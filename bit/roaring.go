@@ -0,0 +1,484 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"iter"
+	"math"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bits-and-blooms/bitset"
+)
+
+// Roaring represents a compressed bitmap over 32-bit indexes, suited for sparse and clustered bits
+//   - keys []uint16 - the sorted high 16 bits of the indexes, one for each container
+//   - containers []container - the containers holding the low 16 bits of the indexes (array, bitmap or run)
+//
+// NOTE: The length of a roaring bitmap is the index of its highest set bit + 1 (Len() = 0 for empty bitmaps),
+// so the Mask operations behave as for a bitset of that length
+//
+// WARNING: All bitwise operations with effects are destructive and will modify the given bitset (parameter - other),
+// exactly as for BitMask
+//
+//	Roaring.Difference(other) -> other = other & (~Roaring)
+//	Roaring.SymmetricalDifference(other) -> other = other ^ Roaring
+type Roaring struct {
+	keys       []uint16
+	containers []container
+}
+
+// NewRoaring creates a new empty roaring bitmap
+func NewRoaring() *Roaring {
+	return &Roaring{}
+}
+
+// RoaringOf creates a new roaring bitmap with the given bits set
+func RoaringOf(indexes ...uint32) *Roaring {
+	r := NewRoaring()
+	for _, index := range indexes {
+		r.Set(index)
+	}
+	return r
+}
+
+// Set sets the bit at the given index
+func (r *Roaring) Set(index uint32) {
+	position, found := r.search(uint16(index >> 16))
+	if !found {
+		r.keys = slices.Insert(r.keys, position, uint16(index>>16))
+		r.containers = slices.Insert(r.containers, position, container(&arrayContainer{}))
+	}
+	r.containers[position] = r.containers[position].add(uint16(index))
+}
+
+// Clear clears the bit at the given index
+func (r *Roaring) Clear(index uint32) {
+	position, found := r.search(uint16(index >> 16))
+	if !found {
+		return
+	}
+
+	r.containers[position] = r.containers[position].remove(uint16(index))
+	if r.containers[position].cardinality() == 0 {
+		r.keys = slices.Delete(r.keys, position, position+1)
+		r.containers = slices.Delete(r.containers, position, position+1)
+	}
+}
+
+// RunOptimize converts each container to its smallest representation, using run containers for long
+// intervals of set bits
+func (r *Roaring) RunOptimize() {
+	for index, c := range r.containers {
+		r.containers[index] = optimize(c)
+	}
+}
+
+// Ones returns an iterator over the indexes of the set bits, in ascending order
+func (r *Roaring) Ones() iter.Seq[uint] {
+	return func(yield func(uint) bool) {
+		for index, c := range r.containers {
+			high := uint(r.keys[index]) << 16
+			for low, found := c.next(0); found; low, found = c.next(low + 1) {
+				if !yield(high | uint(low)) {
+					return
+				}
+				if low == math.MaxUint16 {
+					break
+				}
+			}
+		}
+	}
+}
+
+// Len returns the number of bits in the roaring bitmap (the index of the highest set bit + 1)
+func (r *Roaring) Len() uint {
+	if len(r.containers) == 0 {
+		return 0
+	}
+
+	last := len(r.containers) - 1
+	return uint(r.keys[last])<<16 | uint(r.containers[last].maximum()) + 1
+}
+
+// Test returns whether the bit at the given index is set
+func (r *Roaring) Test(index uint) bool {
+	if index > math.MaxUint32 {
+		return false
+	}
+
+	position, found := r.search(uint16(index >> 16))
+	return found && r.containers[position].has(uint16(index))
+}
+
+// String returns a string representation of the roaring bitmap
+func (r *Roaring) String() string {
+	var builder strings.Builder
+	builder.WriteByte('{')
+	for index := range r.Ones() {
+		if builder.Len() > 1 {
+			builder.WriteByte(',')
+		}
+		builder.WriteString(strconv.FormatUint(uint64(index), 10))
+	}
+	builder.WriteByte('}')
+	return builder.String()
+}
+
+// NextSet returns the next bit set from the specified index, including possibly the current index
+// along with an error code (true = valid, false = no set bit found, i.e all bits are clear)
+func (r *Roaring) NextSet(index uint) (uint, bool) {
+	if index > math.MaxUint32 {
+		return 0, false
+	}
+
+	position, found := r.search(uint16(index >> 16))
+	if found {
+		if low, ok := r.containers[position].next(uint16(index)); ok {
+			return uint(r.keys[position])<<16 | uint(low), true
+		}
+		position++
+	}
+	if position == len(r.containers) {
+		return 0, false
+	}
+
+	low, _ := r.containers[position].next(0)
+	return uint(r.keys[position])<<16 | uint(low), true
+}
+
+// NextSetMany returns many next bit sets from the specified index, including possibly the current index
+// and up to cap(buffer), along with the last index found (an empty slice means no set bit was found)
+func (r *Roaring) NextSetMany(index uint, buffer []uint) (uint, []uint) {
+	buffer = buffer[:0]
+	if index > math.MaxUint32 {
+		return 0, buffer
+	}
+
+	position, found := r.search(uint16(index >> 16))
+	low := uint16(0)
+	if found {
+		low = uint16(index)
+	}
+
+	for ; position < len(r.containers) && len(buffer) < cap(buffer); position++ {
+		high := uint(r.keys[position]) << 16
+		c := r.containers[position]
+		for value, ok := c.next(low); ok && len(buffer) < cap(buffer); value, ok = c.next(value + 1) {
+			buffer = append(buffer, high|uint(value))
+			if value == math.MaxUint16 {
+				break
+			}
+		}
+		low = 0
+	}
+
+	if len(buffer) == 0 {
+		return 0, buffer
+	}
+	return buffer[len(buffer)-1], buffer
+}
+
+// NextClear returns the next bit clear from the specified index, including possibly the current index
+// along with an error code (true = valid, false = no clear bit found, i.e all bits are set)
+func (r *Roaring) NextClear(index uint) (uint, bool) {
+	length := r.Len()
+	for index < length {
+		position, found := r.search(uint16(index >> 16))
+		if !found {
+			return index, true
+		}
+
+		if low, ok := r.containers[position].nextAbsent(uint16(index)); ok {
+			index = index&^(containerBits-1) | uint(low)
+			return index, index < length
+		}
+		index = (index>>16 + 1) << 16
+	}
+	return 0, false
+}
+
+// Clone returns a new BitSet with the same bits set and same size
+func (r *Roaring) Clone() *bitset.BitSet {
+	bits := bitset.New(r.Len())
+	r.Union(bits)
+	return bits
+}
+
+// Copy copies bits into a destination BitSet (using the Go array copy semantics)
+//
+// The number of bits copied is the minimum of the number of bits - min( Len(mask), Len(other) )
+func (r *Roaring) Copy(other *bitset.BitSet) uint {
+	count := min(r.Len(), other.Len())
+	if count == 0 {
+		return 0
+	}
+
+	words := other.Words()
+	limit := int((count + wordSize - 1) / wordSize)
+	clear(words[:limit])
+	r.apply(words[:limit], func(target, source []uint64) {
+		for index := range target {
+			target[index] |= source[index]
+		}
+	})
+
+	if tail := other.Len() % wordSize; tail != 0 && limit == len(words) {
+		words[limit-1] &= 1<<tail - 1
+	}
+	return count
+}
+
+// CopyFull copies into a destination BitSet such that the destination is identical to the source after the operation
+func (r *Roaring) CopyFull(other *bitset.BitSet) {
+	r.Clone().CopyFull(other)
+}
+
+// Count returns the number of set bits
+func (r *Roaring) Count() uint {
+	count := 0
+	for _, c := range r.containers {
+		count += c.cardinality()
+	}
+	return uint(count)
+}
+
+// Equal returns whether the roaring bitmap and the given mask are the same (compares both bits and size)
+func (r *Roaring) Equal(other Mask) bool {
+	return equal(r, other)
+}
+
+// Difference performs the difference operation with the given BitSet:
+//
+//	other = other & (~Roaring)
+func (r *Roaring) Difference(other *bitset.BitSet) {
+	r.apply(other.Words(), func(target, source []uint64) {
+		for index := range target {
+			target[index] &^= source[index]
+		}
+	})
+}
+
+// DifferenceCardinality returns the cardinality of the difference operation with the given mask:
+//
+//	count( other & (~Roaring) )
+func (r *Roaring) DifferenceCardinality(other Mask) uint {
+	return other.Count() - intersectionCardinality(r, other)
+}
+
+// Intersection performs the intersection operation with the given BitSet:
+//
+//	other = other & Roaring
+func (r *Roaring) Intersection(other *bitset.BitSet) {
+	words := other.Words()
+	scratch := make([]uint64, containerWords)
+
+	position := 0
+	for start := 0; start < len(words); start += containerWords {
+		block := words[start:min(start+containerWords, len(words))]
+		key := start / containerWords
+		for position < len(r.keys) && int(r.keys[position]) < key {
+			position++
+		}
+
+		if position == len(r.keys) || int(r.keys[position]) != key {
+			clear(block)
+			continue
+		}
+		source := r.containers[position].words(scratch)
+		for index := range block {
+			block[index] &= source[index]
+		}
+	}
+}
+
+// IntersectionCardinality returns the cardinality of the intersection operation with the given mask:
+//
+//	count( other & Roaring )
+func (r *Roaring) IntersectionCardinality(other Mask) uint {
+	return intersectionCardinality(r, other)
+}
+
+// Union performs the union operation with the given BitSet:
+//
+//	other = other | Roaring
+func (r *Roaring) Union(other *bitset.BitSet) {
+	r.grow(other)
+	r.apply(other.Words(), func(target, source []uint64) {
+		for index := range target {
+			target[index] |= source[index]
+		}
+	})
+}
+
+// UnionCardinality returns the cardinality of the union operation with the given mask:
+//
+//	count( other | Roaring )
+func (r *Roaring) UnionCardinality(other Mask) uint {
+	return r.Count() + other.Count() - intersectionCardinality(r, other)
+}
+
+// SymmetricalDifference performs the symmetrical difference operation with the given BitSet:
+//
+//	other = other ^ Roaring
+func (r *Roaring) SymmetricalDifference(other *bitset.BitSet) {
+	r.grow(other)
+	r.apply(other.Words(), func(target, source []uint64) {
+		for index := range target {
+			target[index] ^= source[index]
+		}
+	})
+}
+
+// SymmetricalDifferenceCardinality returns the cardinality of the symmetrical difference operation
+// with the given mask:
+//
+//	count( other ^ Roaring )
+func (r *Roaring) SymmetricalDifferenceCardinality(other Mask) uint {
+	return r.Count() + other.Count() - 2*intersectionCardinality(r, other)
+}
+
+// All returns true if all bits are set, false otherwise (returns true for empty bitmaps)
+func (r *Roaring) All() bool {
+	return r.Count() == r.Len()
+}
+
+// None returns true if no bit is set, false otherwise (returns true for empty bitmaps)
+func (r *Roaring) None() bool {
+	return len(r.containers) == 0
+}
+
+// Any returns true if any bit is set, false otherwise
+func (r *Roaring) Any() bool {
+	return len(r.containers) > 0
+}
+
+// IsSuperSet returns true if this is a superset of the other set
+func (r *Roaring) IsSuperSet(other Mask) bool {
+	return isSuperSet(r, other)
+}
+
+// IsStrictSuperSet returns true if this is a strict superset of the other set
+func (r *Roaring) IsStrictSuperSet(other Mask) bool {
+	return r.Count() > other.Count() && isSuperSet(r, other)
+}
+
+// IsSubSetOf returns true if this is a subset of the other set
+func (r *Roaring) IsSubSetOf(other Mask) bool {
+	return isSuperSet(other, r)
+}
+
+// IsStrictSubSetOf returns true if this is a strict subset of the other set
+func (r *Roaring) IsStrictSubSetOf(other Mask) bool {
+	return other.Count() > r.Count() && isSuperSet(other, r)
+}
+
+// Rank returns the number of set bits up to and including the index that are set in the roaring bitmap
+func (r *Roaring) Rank(index uint) uint {
+	if index > math.MaxUint32 {
+		return r.Count()
+	}
+
+	count := 0
+	for position, key := range r.keys {
+		if key > uint16(index>>16) {
+			break
+		}
+		if key < uint16(index>>16) {
+			count += r.containers[position].cardinality()
+		} else {
+			count += r.containers[position].rank(uint16(index))
+		}
+	}
+	return uint(count)
+}
+
+// Select returns the index of the jth set bit, where j is the argument
+//
+// WARNING: When j is out of range, the function returns the length of the roaring bitmap
+func (r *Roaring) Select(index uint) uint {
+	for position, c := range r.containers {
+		count := uint(c.cardinality())
+		if index < count {
+			return uint(r.keys[position])<<16 | uint(c.selectAt(int(index)))
+		}
+		index -= count
+	}
+	return r.Len()
+}
+
+// intersectBits returns the cardinality of the intersection with the given bitset (word-wise)
+func (r *Roaring) intersectBits(other *bitset.BitSet) uint {
+	count := 0
+	r.apply(other.Words(), func(target, source []uint64) {
+		for index := range target {
+			count += bits.OnesCount64(target[index] & source[index])
+		}
+	})
+	return uint(count)
+}
+
+// intersectRoaring returns the cardinality of the intersection with the given roaring bitmap (container-wise)
+func (r *Roaring) intersectRoaring(other *Roaring) uint {
+	scratch, otherScratch := make([]uint64, containerWords), make([]uint64, containerWords)
+
+	count := 0
+	for position, key := range r.keys {
+		otherPosition, found := other.search(key)
+		if !found {
+			continue
+		}
+
+		words := r.containers[position].words(scratch)
+		for index, word := range other.containers[otherPosition].words(otherScratch) {
+			count += bits.OnesCount64(word & words[index])
+		}
+	}
+	return uint(count)
+}
+
+// search returns the position of the container of the given key, and whether it exists
+func (r *Roaring) search(key uint16) (int, bool) {
+	return slices.BinarySearch(r.keys, key)
+}
+
+// grow ensures the given bitset is long enough to hold all the bits of the roaring bitmap
+func (r *Roaring) grow(other *bitset.BitSet) {
+	if length := r.Len(); length > other.Len() {
+		other.Set(length - 1).Clear(length - 1)
+	}
+}
+
+// apply calls f for each container overlapping the given words, with the overlapping words (target)
+// and the matching words of the container (source)
+func (r *Roaring) apply(words []uint64, f func(target, source []uint64)) {
+	scratch := make([]uint64, containerWords)
+	for position, key := range r.keys {
+		start := int(key) * containerWords
+		if start >= len(words) {
+			return
+		}
+
+		target := words[start:min(start+containerWords, len(words))]
+		f(target, r.containers[position].words(scratch)[:len(target)])
+	}
+}
@@ -0,0 +1,198 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package bit
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/bits-and-blooms/bitset"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ Mask = (*Roaring)(nil)
+
+// randomRoaring returns a roaring bitmap mixing sparse, dense and run containers, along with the equivalent bitset
+func randomRoaring() (*Roaring, *bitset.BitSet) {
+	roaring, bits := NewRoaring(), bitset.New(0)
+	set := func(index uint32) {
+		roaring.Set(index)
+		bits.Set(uint(index))
+	}
+
+	for range numBits {
+		set(rand.Uint32N(1 << 20))
+	}
+	for range 3 * arrayMaxSize {
+		set(1<<20 + rand.Uint32N(containerBits))
+	}
+	for index := uint32(3 << 20); index < 3<<20+2*containerBits; index++ {
+		set(index)
+	}
+	return roaring, bits
+}
+
+func TestRoaring_SetClear(t *testing.T) {
+	roaring := NewRoaring()
+	assert.True(t, roaring.None())
+	assert.Equal(t, uint(0), roaring.Len())
+
+	roaring.Set(7)
+	roaring.Set(1 << 20)
+	roaring.Set(1<<32 - 1)
+	assert.True(t, roaring.Test(7))
+	assert.True(t, roaring.Test(1<<20))
+	assert.True(t, roaring.Test(1<<32-1))
+	assert.False(t, roaring.Test(8))
+	assert.False(t, roaring.Test(1<<32))
+	assert.Equal(t, uint(3), roaring.Count())
+	assert.Equal(t, uint(1<<32), roaring.Len())
+	assert.Equal(t, "{7,1048576,4294967295}", roaring.String())
+
+	roaring.Clear(1<<32 - 1)
+	roaring.Clear(8)
+	assert.Equal(t, uint(2), roaring.Count())
+	assert.Equal(t, uint(1<<20+1), roaring.Len())
+	assert.Equal(t, []uint{7, 1 << 20}, slices.Collect(roaring.Ones()))
+}
+
+func TestRoaring_Containers(t *testing.T) {
+	roaring := NewRoaring()
+	for index := range uint32(2 * arrayMaxSize) {
+		roaring.Set(2 * index)
+	}
+	assert.IsType(t, &bitmapContainer{}, roaring.containers[0])
+
+	for index := range uint32(arrayMaxSize) {
+		roaring.Clear(2 * index)
+	}
+	assert.IsType(t, &arrayContainer{}, roaring.containers[0])
+	assert.Equal(t, uint(arrayMaxSize), roaring.Count())
+
+	for index := uint32(0); index < containerBits; index++ {
+		roaring.Set(containerBits + index)
+	}
+	roaring.RunOptimize()
+	assert.IsType(t, &arrayContainer{}, roaring.containers[0])
+	assert.IsType(t, &runContainer{}, roaring.containers[1])
+	assert.Equal(t, uint(arrayMaxSize+containerBits), roaring.Count())
+
+	roaring.Clear(containerBits + 5)
+	assert.IsType(t, &bitmapContainer{}, roaring.containers[1])
+	assert.False(t, roaring.Test(containerBits+5))
+	assert.Equal(t, uint(arrayMaxSize+containerBits-1), roaring.Count())
+}
+
+func TestRoaring_Navigation(t *testing.T) {
+	for _, optimize := range []bool{false, true} {
+		roaring, bits := randomRoaring()
+		if optimize {
+			roaring.RunOptimize()
+		}
+
+		assert.Equal(t, bits.Len(), roaring.Len())
+		assert.Equal(t, bits.Count(), roaring.Count())
+		assert.Equal(t, bits.String(), roaring.String())
+		assert.True(t, roaring.Equal(NewMask(bits)))
+		assert.True(t, NewMask(roaring.Clone()).Equal(NewMask(bits)))
+
+		for range numBits {
+			index := rand.UintN(bits.Len() + 10)
+
+			assert.Equal(t, bits.Test(index), roaring.Test(index))
+			assert.Equal(t, bits.Rank(index), roaring.Rank(index))
+
+			expected, found := bits.NextSet(index)
+			actual, ok := roaring.NextSet(index)
+			assert.Equal(t, found, ok)
+			assert.Equal(t, expected, actual)
+
+			expected, found = bits.NextClear(index)
+			actual, ok = roaring.NextClear(index)
+			assert.Equal(t, found, ok)
+			if found {
+				assert.Equal(t, expected, actual)
+			}
+
+			j := rand.UintN(bits.Count() + 10)
+			assert.Equal(t, bits.Select(j), roaring.Select(j))
+		}
+	}
+}
+
+func TestRoaring_Operations(t *testing.T) {
+	for _, optimize := range []bool{false, true} {
+		roaring, bits := randomRoaring()
+		if optimize {
+			roaring.RunOptimize()
+		}
+
+		other := bitset.New(0)
+		for range 4 * numBits {
+			other.Set(rand.UintN(4 << 20))
+		}
+
+		assert.Equal(t, bits.IntersectionCardinality(other), roaring.IntersectionCardinality(NewMask(other)))
+		assert.Equal(t, bits.UnionCardinality(other), roaring.UnionCardinality(NewMask(other)))
+		assert.Equal(t, other.DifferenceCardinality(bits), roaring.DifferenceCardinality(NewMask(other)))
+		assert.Equal(t, bits.SymmetricDifferenceCardinality(other), roaring.SymmetricalDifferenceCardinality(NewMask(other)))
+
+		expected, actual := other.Intersection(bits), other.Clone()
+		roaring.Intersection(actual)
+		assert.Equal(t, expected.Count(), actual.Count())
+		assert.True(t, expected.IsSuperSet(actual) && actual.IsSuperSet(expected))
+
+		expected, actual = other.Union(bits), other.Clone()
+		roaring.Union(actual)
+		assert.True(t, expected.Equal(actual))
+
+		expected, actual = other.Difference(bits), other.Clone()
+		roaring.Difference(actual)
+		assert.True(t, expected.Equal(actual))
+
+		expected, actual = other.SymmetricDifference(bits), other.Clone()
+		roaring.SymmetricalDifference(actual)
+		assert.True(t, expected.Equal(actual))
+
+		assert.True(t, roaring.IsSubSetOf(NewMask(expected.Union(bits))))
+		assert.True(t, roaring.IsSuperSet(NewMask(bits)))
+		assert.False(t, roaring.IsStrictSuperSet(NewMask(bits)))
+		assert.False(t, roaring.IsStrictSubSetOf(NewMask(bits)))
+	}
+}
+
+func TestRoaring_Copy(t *testing.T) {
+	roaring := RoaringOf(1, 70, 200)
+
+	other := bitset.New(100).Set(2).Set(99)
+	assert.Equal(t, uint(100), roaring.Copy(other))
+	assert.Equal(t, "{1,70}", other.String())
+	assert.Equal(t, uint(100), other.Len())
+
+	full := bitset.New(10)
+	roaring.CopyFull(full)
+	assert.Equal(t, uint(201), full.Len())
+	assert.True(t, roaring.Equal(NewMask(full)))
+
+	assert.True(t, NewRoaring().All())
+	assert.True(t, RoaringOf(0, 1, 2).All())
+	assert.False(t, roaring.All())
+}
@@ -30,3 +30,14 @@ func NextPowerOfTwo(value uint) uint {
 
 	return value
 }
+
+// Mix scrambles the bits of the given value (the splitmix64 finalizer), so that close values
+// produce unrelated hashes
+func Mix(value uint64) uint64 {
+	value ^= value >> 30
+	value *= 0xbf58476d1ce4e5b9
+	value ^= value >> 27
+	value *= 0x94d049bb133111eb
+	value ^= value >> 31
+	return value
+}
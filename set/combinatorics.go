@@ -0,0 +1,119 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// Powerset - returns a lazy iterator over all the subsets of the given set (including the empty set and
+// the set itself), in Gray code order, so consecutive subsets differ by exactly one key
+//
+// NOTE: The set is traversed when the iteration starts, and a set of n keys has 2^n subsets
+//
+// WARNING: The yielded set is reused (and modified) between iterations, and must be copied to be kept
+func Powerset[T comparable](s *Set[T]) iter.Seq[*Set[T]] {
+	return func(yield func(*Set[T]) bool) {
+		keys := s.Slice()
+		subset := New[T](len(keys))
+
+		if !yield(subset) {
+			return
+		}
+
+		// The subset of step i differs from the previous one by the key at the index of the lowest set bit of i
+		// (a set of 64 keys or more has too many subsets to be exhausted anyway)
+		for step := uint64(1); len(keys) >= 64 || step < 1<<len(keys); step++ {
+			key := keys[bits.TrailingZeros64(step)]
+			if !subset.Insert(key) {
+				subset.Remove(key)
+			}
+
+			if !yield(subset) {
+				return
+			}
+		}
+	}
+}
+
+// Combinations - returns a lazy iterator over all the subsets of k keys of the given set
+//
+// NOTE: The set is traversed when the iteration starts, and a set of n keys has C(n, k) subsets of k keys
+//
+// WARNING: The yielded set is reused (and modified) between iterations, and must be copied to be kept
+func Combinations[T comparable](s *Set[T], k int) iter.Seq[*Set[T]] {
+	return func(yield func(*Set[T]) bool) {
+		keys := s.Slice()
+		if k < 0 || k > len(keys) {
+			return
+		}
+
+		// The indexes of the keys of the current subset, in ascending order
+		indexes := make([]int, k)
+		subset := New[T](k)
+		for index := range indexes {
+			indexes[index] = index
+			subset.keys[keys[index]] = empty
+		}
+
+		for {
+			if !yield(subset) {
+				return
+			}
+
+			// Find the rightmost index which can still be advanced
+			cursor := k - 1
+			for cursor >= 0 && indexes[cursor] == len(keys)-k+cursor {
+				cursor--
+			}
+			if cursor < 0 {
+				return
+			}
+
+			// Advance it, and reset the following indexes right after it
+			for index := cursor; index < k; index++ {
+				delete(subset.keys, keys[indexes[index]])
+			}
+			indexes[cursor]++
+			for index := cursor; index < k; index++ {
+				if index > cursor {
+					indexes[index] = indexes[index-1] + 1
+				}
+				subset.keys[keys[indexes[index]]] = empty
+			}
+		}
+	}
+}
+
+// Product - returns a lazy iterator over all the pairs of keys (the cartesian product) of the given sets
+//
+//	result = Product(a, b) =>  result <- a × b
+func Product[A comparable, B comparable](a *Set[A], b *Set[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for first := range a.keys {
+			for second := range b.keys {
+				if !yield(first, second) {
+					return
+				}
+			}
+		}
+	}
+}
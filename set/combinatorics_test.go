@@ -0,0 +1,114 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPowerset(t *testing.T) {
+	set := From([]int{1, 2, 3, 4})
+
+	subsets := make([]*Set[int], 0)
+	var previous *Set[int]
+	for subset := range Powerset(set) {
+		if previous != nil {
+			assert.Equal(t, 1, previous.SymmetricDifference(subset).Size())
+		}
+		assert.True(t, subset.IsSubsetOf(set))
+		previous = subset.Copy()
+		subsets = append(subsets, previous)
+	}
+
+	assert.Len(t, subsets, 16)
+	assert.True(t, subsets[0].Empty())
+	assert.Len(t, distinct(subsets), 16)
+
+	count := 0
+	for range Powerset(New[int](0)) {
+		count++
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestPowerset_Large(t *testing.T) {
+	keys := make([]int, 100)
+	for index := range keys {
+		keys[index] = index
+	}
+
+	count := 0
+	for range Powerset(From(keys)) {
+		if count++; count == 1000 {
+			break
+		}
+	}
+	assert.Equal(t, 1000, count)
+}
+
+func TestCombinations(t *testing.T) {
+	set := From([]int{1, 2, 3, 4, 5})
+
+	subsets := make([]*Set[int], 0)
+	for subset := range Combinations(set, 3) {
+		assert.Equal(t, 3, subset.Size())
+		assert.True(t, subset.IsSubsetOf(set))
+		subsets = append(subsets, subset.Copy())
+	}
+
+	assert.Len(t, subsets, 10)
+	assert.Len(t, distinct(subsets), 10)
+
+	count := func(k int) int {
+		total := 0
+		for range Combinations(set, k) {
+			total++
+		}
+		return total
+	}
+
+	assert.Equal(t, 1, count(0))
+	assert.Equal(t, 5, count(1))
+	assert.Equal(t, 1, count(5))
+	assert.Equal(t, 0, count(6))
+	assert.Equal(t, 0, count(-1))
+}
+
+func TestProduct(t *testing.T) {
+	pairs := make([]string, 0)
+	for number, letter := range Product(From([]int{1, 2}), From([]string{"a", "b", "c"})) {
+		pairs = append(pairs, letter+string(rune('0'+number)))
+	}
+
+	slices.Sort(pairs)
+	assert.Equal(t, []string{"a1", "a2", "b1", "b2", "c1", "c2"}, pairs)
+}
+
+// distinct - returns the distinct subsets, keyed by their sorted keys
+func distinct(subsets []*Set[int]) map[string]bool {
+	result := make(map[string]bool, len(subsets))
+	for _, subset := range subsets {
+		result[subset.String()] = true
+	}
+	return result
+}
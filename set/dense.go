@@ -0,0 +1,299 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"iter"
+
+	"github.com/andrei-cosmin/sandata/bit"
+	"github.com/bits-and-blooms/bitset"
+)
+
+// denseBatch - the number of keys extracted at once when iterating a Dense set
+const denseBatch = 256
+
+// Unsigned - constraint for the unsigned integer types which can be stored in a Dense set
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// NewDense - creates a new Dense set with pre-allocated memory for keys up to the given capacity
+func NewDense[T Unsigned](capacity uint) *Dense[T] {
+	return &Dense[T]{
+		bits: bitset.New(capacity),
+	}
+}
+
+// DenseFrom - creates a new Dense set containing each key in the given slice
+func DenseFrom[T Unsigned](keys []T) *Dense[T] {
+	s := NewDense[T](0)
+	s.InsertSlice(keys)
+	return s
+}
+
+// Dense - represents a set structure for small unsigned integer keys (such as entity ids), backed by a bitset,
+// where the set algebra runs one 64-bit word at a time
+//   - bits *bitset.BitSet - the bitset, where the bit of each key of the set is set
+//
+// NOTE: The memory used is proportional to the largest key of the set, not to the number of keys
+type Dense[T Unsigned] struct {
+	bits *bitset.BitSet
+}
+
+// Insert - inserts the key into the set, and returns
+// true if the set was modified (key didn't exist before), false otherwise
+func (s *Dense[T]) Insert(key T) bool {
+	if s.bits.Test(uint(key)) {
+		return false
+	}
+
+	s.bits.Set(uint(key))
+	return true
+}
+
+// InsertSlice - inserts each key from the given slice into the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Dense[T]) InsertSlice(keys []T) bool {
+	modified := false
+
+	for _, item := range keys {
+		if s.Insert(item) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// InsertSet - inserts each element of the given set into the current set, and returns
+// true if the current set was modified (at least once), false otherwise
+func (s *Dense[T]) InsertSet(other *Dense[T]) bool {
+	modified := !s.bits.IsSuperSet(other.bits)
+	if modified {
+		s.bits.InPlaceUnion(other.bits)
+	}
+	return modified
+}
+
+// Remove - removes the key from the set, and returns
+// true if the set was modified (key existed before), false otherwise
+func (s *Dense[T]) Remove(key T) bool {
+	if !s.bits.Test(uint(key)) {
+		return false
+	}
+
+	s.bits.Clear(uint(key))
+	return true
+}
+
+// RemoveSlice - removes each key in keys from the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Dense[T]) RemoveSlice(keys []T) bool {
+	modified := false
+
+	for _, item := range keys {
+		if s.Remove(item) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// RemoveSet - removes each element of the given set from the current set, and returns
+// true if the current set was modified (at least once), false otherwise
+func (s *Dense[T]) RemoveSet(other *Dense[T]) bool {
+	modified := s.bits.IntersectionCardinality(other.bits) > 0
+	if modified {
+		s.bits.InPlaceDifference(other.bits)
+	}
+	return modified
+}
+
+// Has - returns true if key exists in the set, false otherwise
+func (s *Dense[T]) Has(key T) bool {
+	return s.bits.Test(uint(key))
+}
+
+// HasSlice - returns true if all keys are present in the set, false otherwise
+//
+// NOTE: This method will return true for an empty slice
+func (s *Dense[T]) HasSlice(keys []T) bool {
+	for _, key := range keys {
+		if !s.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasSet - returns true if all keys from the other set are present in the current set, false otherwise
+//
+// NOTE: This method will return true for an empty given set
+func (s *Dense[T]) HasSet(other *Dense[T]) bool {
+	return other.bits.DifferenceCardinality(s.bits) == 0
+}
+
+// FilterFunc - filters the set using the given filter function, and returns
+// true if the set was modified, false otherwise
+func (s *Dense[T]) FilterFunc(filter func(T) bool) bool {
+	modified := false
+
+	for key := range s.All() {
+		if !filter(key) {
+			s.bits.Clear(uint(key))
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Size - returns the cardinality of the set
+func (s *Dense[T]) Size() int {
+	return int(s.bits.Count())
+}
+
+// Empty - returns true if the set contains no elements, false otherwise
+func (s *Dense[T]) Empty() bool {
+	return s.bits.None()
+}
+
+// Union - returns new set representing the union of the current and given sets
+//
+//	result = set.union(other) =>  result <- set ∪ other
+func (s *Dense[T]) Union(other *Dense[T]) *Dense[T] {
+	return &Dense[T]{bits: s.bits.Union(other.bits)}
+}
+
+// Difference - returns a set representing the difference between the current and given sets
+//
+//	result = set.difference(other) =>  result <- set \ other
+func (s *Dense[T]) Difference(other *Dense[T]) *Dense[T] {
+	return &Dense[T]{bits: s.bits.Difference(other.bits)}
+}
+
+// Intersect - returns a set representing the intersection of the current and given set
+//
+//	result = set.intersect(other) =>  result <- set ∩ other
+func (s *Dense[T]) Intersect(other *Dense[T]) *Dense[T] {
+	return &Dense[T]{bits: s.bits.Intersection(other.bits)}
+}
+
+// SymmetricDifference - returns a set representing the keys present in exactly one of the current and given sets
+//
+//	result = set.symmetricDifference(other) =>  result <- (set \ other) ∪ (other \ set)
+func (s *Dense[T]) SymmetricDifference(other *Dense[T]) *Dense[T] {
+	return &Dense[T]{bits: s.bits.SymmetricDifference(other.bits)}
+}
+
+// IsSubsetOf - returns true if all keys from the current set are present in the other set, false otherwise
+func (s *Dense[T]) IsSubsetOf(other *Dense[T]) bool {
+	return other.HasSet(s)
+}
+
+// IsStrictSubsetOf - returns true if the current set is a subset of the other set,
+// and the other set contains at least one key which is not present in the current set, false otherwise
+func (s *Dense[T]) IsStrictSubsetOf(other *Dense[T]) bool {
+	return other.HasSet(s) && other.bits.DifferenceCardinality(s.bits) > 0
+}
+
+// IsSupersetOf - returns true if all keys from the other set are present in the current set, false otherwise
+func (s *Dense[T]) IsSupersetOf(other *Dense[T]) bool {
+	return s.HasSet(other)
+}
+
+// IsDisjoint - returns true if the current and given sets have no keys in common, false otherwise
+func (s *Dense[T]) IsDisjoint(other *Dense[T]) bool {
+	return s.bits.IntersectionCardinality(other.bits) == 0
+}
+
+// Copy - returns a copy of the current set
+func (s *Dense[T]) Copy() *Dense[T] {
+	return &Dense[T]{bits: s.bits.Clone()}
+}
+
+// Slice - returns a copy of the current set as a slice, in ascending order
+func (s *Dense[T]) Slice() []T {
+	keys := make([]T, 0, s.Size())
+	for key := range s.All() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Set - returns a copy of the current set as a hash based Set
+func (s *Dense[T]) Set() *Set[T] {
+	other := New[T](s.Size())
+	for key := range s.All() {
+		other.keys[key] = empty
+	}
+	return other
+}
+
+// All - returns an iterator over the keys of the set in ascending order
+func (s *Dense[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		buffer := make([]uint, denseBatch)
+
+		for index, found := s.bits.NextSetMany(0, buffer); len(found) > 0; index, found = s.bits.NextSetMany(index+1, buffer) {
+			for _, key := range found {
+				if !yield(T(key)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Equal - returns true if the sets contain the same keys, false otherwise
+//
+// NOTE: Unlike bitset.BitSet.Equal, the capacity of the sets is not compared
+func (s *Dense[T]) Equal(other *Dense[T]) bool {
+	return s.bits.SymmetricDifferenceCardinality(other.bits) == 0
+}
+
+// EqualSlice - returns true if the current set and given keys contain exactly the same keys.
+func (s *Dense[T]) EqualSlice(items []T) bool {
+	if len(items) != s.Size() {
+		return false
+	}
+	return s.HasSlice(items)
+}
+
+// ForEach - iterates over the set in ascending order, calling the given function `f` for each key
+func (s *Dense[T]) ForEach(f func(T)) {
+	for key := range s.All() {
+		f(key)
+	}
+}
+
+// Mask - returns a bit.Mask view over the set (usable with array.Array.ClearAll)
+//
+// NOTE: The view shares the bits of the set, and reflects its later modifications
+func (s *Dense[T]) Mask() *bit.BitMask {
+	return bit.NewMask(s.bits)
+}
+
+// Bits - returns the underlying bitset
+func (s *Dense[T]) Bits() *bitset.BitSet {
+	return s.bits
+}
@@ -0,0 +1,88 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/array"
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type entityId uint32
+
+func TestDense_InsertRemove(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+	set := NewDense[uint](0)
+
+	for _, value := range values {
+		assert.True(t, set.Insert(value))
+		assert.False(t, set.Insert(value))
+	}
+
+	assert.Equal(t, defaultSize, set.Size())
+	assert.True(t, set.EqualSlice(values))
+	assert.Equal(t, slices.Sorted(slices.Values(values)), set.Slice())
+
+	assert.True(t, set.RemoveSlice(values[:defaultSize/2]))
+	assert.False(t, set.Remove(values[0]))
+	assert.True(t, set.EqualSlice(values[defaultSize/2:]))
+	assert.True(t, set.Set().EqualSlice(values[defaultSize/2:]))
+}
+
+func TestDense_Algebra(t *testing.T) {
+	s1 := DenseFrom([]entityId{1, 2, 3, 4, 200})
+	s2 := DenseFrom([]entityId{3, 4, 5})
+
+	assert.Equal(t, []entityId{1, 2, 3, 4, 5, 200}, s1.Union(s2).Slice())
+	assert.Equal(t, []entityId{1, 2, 200}, s1.Difference(s2).Slice())
+	assert.Equal(t, []entityId{3, 4}, s1.Intersect(s2).Slice())
+	assert.Equal(t, []entityId{1, 2, 5, 200}, s1.SymmetricDifference(s2).Slice())
+
+	assert.True(t, s1.Intersect(s2).IsStrictSubsetOf(s2))
+	assert.False(t, s2.IsStrictSubsetOf(s2))
+	assert.True(t, s1.IsSupersetOf(DenseFrom([]entityId{200})))
+	assert.False(t, s1.IsDisjoint(s2))
+	assert.True(t, DenseFrom([]entityId{3}).Equal(s1.Intersect(DenseFrom([]entityId{3, 300}))))
+
+	assert.True(t, s2.InsertSet(DenseFrom([]entityId{6})))
+	assert.False(t, s2.InsertSet(DenseFrom([]entityId{6})))
+	assert.True(t, s2.RemoveSet(DenseFrom([]entityId{3, 7})))
+	assert.False(t, s2.RemoveSet(DenseFrom([]entityId{3, 7})))
+	assert.Equal(t, []entityId{4, 5, 6}, s2.Slice())
+
+	assert.True(t, s1.FilterFunc(func(key entityId) bool { return key%2 == 0 }))
+	assert.Equal(t, []entityId{2, 4, 200}, s1.Slice())
+}
+
+func TestDense_Mask(t *testing.T) {
+	values := array.New[string](8)
+	for index := range uint(8) {
+		values.Set(index, "value")
+	}
+
+	values.ClearAll(DenseFrom([]uint8{1, 3, 100}).Mask())
+
+	assert.Equal(t, "", values.Get(1))
+	assert.Equal(t, "", values.Get(3))
+	assert.Equal(t, "value", values.Get(2))
+}
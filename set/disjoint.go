@@ -0,0 +1,191 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import "iter"
+
+// NewDisjointSet - creates a new DisjointSet with pre-allocated memory for the given number of keys
+func NewDisjointSet[T comparable](size int) *DisjointSet[T] {
+	return &DisjointSet[T]{
+		ids:    make(map[T]int, size),
+		keys:   make([]T, 0, size),
+		parent: make([]int, 0, size),
+		rank:   make([]uint8, 0, size),
+		sizes:  make([]int, 0, size),
+	}
+}
+
+// DisjointSet - represents a disjoint-set (union-find) structure, which partitions its keys into groups,
+// using path compression and union by rank (near O(1) amortized operations)
+//   - ids map[T]int - the id of each key
+//   - keys []T - the key of each id
+//   - parent []int - the parent id of each id (roots are their own parent)
+//   - rank []uint8 - the upper bound of the height of each root
+//   - sizes []int - the number of keys in the group of each root
+//   - groups int - the number of groups
+type DisjointSet[T comparable] struct {
+	ids    map[T]int
+	keys   []T
+	parent []int
+	rank   []uint8
+	sizes  []int
+	groups int
+}
+
+// MakeSet - creates a new group containing only the key, and returns
+// true if the structure was modified (key didn't exist before), false otherwise
+func (d *DisjointSet[T]) MakeSet(key T) bool {
+	if _, exists := d.ids[key]; exists {
+		return false
+	}
+
+	id := len(d.keys)
+	d.ids[key] = id
+	d.keys = append(d.keys, key)
+	d.parent = append(d.parent, id)
+	d.rank = append(d.rank, 0)
+	d.sizes = append(d.sizes, 1)
+	d.groups++
+	return true
+}
+
+// Find - returns the representative key of the group of the given key, and false if the key does not exist
+//
+// NOTE: Keys of the same group have the same representative, which may change after a Union
+func (d *DisjointSet[T]) Find(key T) (T, bool) {
+	id, exists := d.ids[key]
+	if !exists {
+		var zero T
+		return zero, false
+	}
+
+	return d.keys[d.root(id)], true
+}
+
+// Union - merges the groups of the two keys (creating the keys which do not exist), and returns
+// true if the structure was modified (keys were in different groups), false otherwise
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	d.MakeSet(a)
+	d.MakeSet(b)
+
+	r1, r2 := d.root(d.ids[a]), d.root(d.ids[b])
+	if r1 == r2 {
+		return false
+	}
+
+	// The shorter tree is attached under the root of the taller one
+	if d.rank[r1] < d.rank[r2] {
+		r1, r2 = r2, r1
+	}
+	if d.rank[r1] == d.rank[r2] {
+		d.rank[r1]++
+	}
+
+	d.parent[r2] = r1
+	d.sizes[r1] += d.sizes[r2]
+	d.groups--
+	return true
+}
+
+// Connected - returns true if the two keys exist and belong to the same group, false otherwise
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	id1, exists1 := d.ids[a]
+	id2, exists2 := d.ids[b]
+
+	return exists1 && exists2 && d.root(id1) == d.root(id2)
+}
+
+// Has - returns true if key exists in the structure, false otherwise
+func (d *DisjointSet[T]) Has(key T) bool {
+	_, exists := d.ids[key]
+	return exists
+}
+
+// Size - returns the number of keys in the group of the given key (0 if the key does not exist)
+func (d *DisjointSet[T]) Size(of T) int {
+	id, exists := d.ids[of]
+	if !exists {
+		return 0
+	}
+
+	return d.sizes[d.root(id)]
+}
+
+// Len - returns the number of keys in the structure
+func (d *DisjointSet[T]) Len() int {
+	return len(d.keys)
+}
+
+// Count - returns the number of groups in the structure
+func (d *DisjointSet[T]) Count() int {
+	return d.groups
+}
+
+// Group - returns the keys of the group of the given key as a Set, and false if the key does not exist
+func (d *DisjointSet[T]) Group(of T) (*Set[T], bool) {
+	id, exists := d.ids[of]
+	if !exists {
+		return nil, false
+	}
+
+	root := d.root(id)
+	group := New[T](d.sizes[root])
+	for other, key := range d.keys {
+		if d.root(other) == root {
+			group.keys[key] = empty
+		}
+	}
+
+	return group, true
+}
+
+// Groups - returns an iterator over the groups of the structure, as Set values
+//
+// NOTE: The groups are computed when the iteration starts, in O(n)
+func (d *DisjointSet[T]) Groups() iter.Seq[*Set[T]] {
+	return func(yield func(*Set[T]) bool) {
+		groups := make(map[int]*Set[T], d.groups)
+
+		for id, key := range d.keys {
+			root := d.root(id)
+			group, exists := groups[root]
+			if !exists {
+				group = New[T](d.sizes[root])
+				groups[root] = group
+			}
+			group.keys[key] = empty
+		}
+
+		for _, group := range groups {
+			if !yield(group) {
+				return
+			}
+		}
+	}
+}
+
+// root - returns the root id of the given id, halving the path along the way
+func (d *DisjointSet[T]) root(id int) int {
+	for d.parent[id] != id {
+		d.parent[id] = d.parent[d.parent[id]]
+		id = d.parent[id]
+	}
+	return id
+}
@@ -0,0 +1,80 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisjointSet_Union(t *testing.T) {
+	disjoint := NewDisjointSet[int](0)
+	for key := range 10 {
+		assert.True(t, disjoint.MakeSet(key))
+	}
+	assert.False(t, disjoint.MakeSet(0))
+	assert.Equal(t, 10, disjoint.Count())
+
+	// Groups by parity
+	for key := 2; key < 10; key++ {
+		assert.True(t, disjoint.Union(key, key-2))
+	}
+	assert.False(t, disjoint.Union(0, 8))
+
+	assert.Equal(t, 2, disjoint.Count())
+	assert.Equal(t, 10, disjoint.Len())
+	assert.True(t, disjoint.Connected(1, 9))
+	assert.False(t, disjoint.Connected(1, 2))
+	assert.False(t, disjoint.Connected(1, 100))
+	assert.Equal(t, 5, disjoint.Size(4))
+	assert.Equal(t, 0, disjoint.Size(100))
+
+	r1, ok := disjoint.Find(2)
+	assert.True(t, ok)
+	r2, _ := disjoint.Find(8)
+	assert.Equal(t, r1, r2)
+	_, ok = disjoint.Find(100)
+	assert.False(t, ok)
+
+	assert.True(t, disjoint.Union(100, 101))
+	assert.Equal(t, 3, disjoint.Count())
+	assert.True(t, disjoint.Has(101))
+}
+
+func TestDisjointSet_Groups(t *testing.T) {
+	disjoint := NewDisjointSet[string](0)
+	disjoint.Union("a", "b")
+	disjoint.Union("c", "d")
+	disjoint.Union("b", "e")
+	disjoint.MakeSet("f")
+
+	groups := make([][]string, 0)
+	for group := range disjoint.Groups() {
+		groups = append(groups, slices.Sorted(slices.Values(group.Slice())))
+	}
+	assert.ElementsMatch(t, [][]string{{"a", "b", "e"}, {"c", "d"}, {"f"}}, groups)
+
+	group, ok := disjoint.Group("e")
+	assert.True(t, ok)
+	assert.True(t, group.EqualSlice([]string{"a", "b", "e"}))
+	assert.True(t, group.Union(From([]string{"z"})).Has("z"))
+}
@@ -0,0 +1,273 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import "time"
+
+// Eviction - represents the order in which keys are evicted from a full Expiring set
+type Eviction uint8
+
+const (
+	// FIFO - the oldest inserted key is evicted first
+	FIFO Eviction = iota
+
+	// LRU - the least recently used (inserted or tested) key is evicted first
+	LRU
+)
+
+// Reason - represents the reason a key left an Expiring set
+type Reason uint8
+
+const (
+	// Expired - the time-to-live of the key elapsed
+	Expired Reason = iota
+
+	// Evicted - the key was evicted to make room for another key
+	Evicted
+)
+
+// String - returns a string representation of the reason
+func (r Reason) String() string {
+	if r == Expired {
+		return "expired"
+	}
+	return "evicted"
+}
+
+// ExpiringConfig - represents the configuration of an Expiring set
+//   - TTL time.Duration - the default time-to-live of the keys (0 for keys which never expire)
+//   - Capacity int - the maximum number of keys (0 for an unbounded set)
+//   - Eviction Eviction - the order in which keys are evicted when the set is full
+//   - Clock func() time.Time - the source of the current time (time.Now if nil)
+type ExpiringConfig struct {
+	TTL      time.Duration
+	Capacity int
+	Eviction Eviction
+	Clock    func() time.Time
+}
+
+// NewExpiring - creates a new Expiring set with the given configuration
+func NewExpiring[T comparable](config ExpiringConfig) *Expiring[T] {
+	if config.Clock == nil {
+		config.Clock = time.Now
+	}
+
+	return &Expiring[T]{
+		order:   NewLinked[T](config.Capacity),
+		expires: make(map[T]time.Time, config.Capacity),
+		config:  config,
+	}
+}
+
+// Expiring - represents a set structure whose keys expire after a time-to-live, and which (optionally)
+// evicts keys in FIFO or LRU order to stay within a maximum size
+//   - order *Linked[T] - the keys of the set, in eviction order (the first key is evicted first)
+//   - expires map[T]time.Time - the expiration time of each key (zero for keys which never expire)
+//   - config ExpiringConfig - the configuration of the set
+//   - observers []func(T, Reason) - the callbacks fired for each expired or evicted key
+//   - earliest time.Time - a lower bound of the expiration times of the keys (zero if no key expires)
+//
+// NOTE: Expired keys are removed lazily, when they are accessed, when the set is full, or by Purge
+// (a full set purges the expired keys before evicting live keys, when the earliest expiration time elapsed)
+type Expiring[T comparable] struct {
+	order     *Linked[T]
+	expires   map[T]time.Time
+	config    ExpiringConfig
+	observers []func(T, Reason)
+	earliest  time.Time
+}
+
+// OnEvict - registers a callback, fired for each key which expired or was evicted
+func (e *Expiring[T]) OnEvict(observer func(key T, reason Reason)) {
+	e.observers = append(e.observers, observer)
+}
+
+// Insert - inserts the key into the set with the default time-to-live, and returns
+// true if the set was modified (key didn't exist before, or was expired), false otherwise
+//
+// NOTE: Inserting an existing key refreshes its time-to-live
+func (e *Expiring[T]) Insert(key T) bool {
+	return e.InsertTTL(key, e.config.TTL)
+}
+
+// InsertTTL - inserts the key into the set with the given time-to-live (0 for a key which never expires),
+// and returns true if the set was modified (key didn't exist before, or was expired), false otherwise
+//
+// NOTE: Inserting an existing key refreshes its time-to-live
+func (e *Expiring[T]) InsertTTL(key T, ttl time.Duration) bool {
+	now := e.config.Clock()
+	inserted := !e.live(key, now)
+
+	if ttl > 0 {
+		e.expires[key] = now.Add(ttl)
+		if e.earliest.IsZero() || e.expires[key].Before(e.earliest) {
+			e.earliest = e.expires[key]
+		}
+	} else {
+		e.expires[key] = time.Time{}
+	}
+
+	if inserted {
+		e.order.Insert(key)
+		e.shrink(now)
+	} else if e.config.Eviction == LRU {
+		e.order.MoveToBack(key)
+	}
+
+	return inserted
+}
+
+// Remove - removes the key from the set (without firing the eviction callbacks), and returns
+// true if the set was modified (key existed before, and was not expired), false otherwise
+func (e *Expiring[T]) Remove(key T) bool {
+	live := e.live(key, e.config.Clock())
+	e.drop(key)
+	return live
+}
+
+// Has - returns true if key exists in the set and is not expired, false otherwise
+//
+// NOTE: For the LRU eviction order, a successful test marks the key as recently used
+func (e *Expiring[T]) Has(key T) bool {
+	if !e.live(key, e.config.Clock()) {
+		return false
+	}
+
+	if e.config.Eviction == LRU {
+		e.order.MoveToBack(key)
+	}
+	return true
+}
+
+// TTL - returns the remaining time-to-live of the key (0 for a key which never expires),
+// and false if the key does not exist or is expired
+func (e *Expiring[T]) TTL(key T) (time.Duration, bool) {
+	now := e.config.Clock()
+	if !e.live(key, now) {
+		return 0, false
+	}
+
+	if expires := e.expires[key]; !expires.IsZero() {
+		return expires.Sub(now), true
+	}
+	return 0, true
+}
+
+// Purge - removes all the expired keys (firing the eviction callbacks), and returns their number
+func (e *Expiring[T]) Purge() int {
+	return e.purge(e.config.Clock())
+}
+
+// Size - returns the number of keys in the set which are not expired
+//
+// NOTE: The expired keys are purged first, in O(n)
+func (e *Expiring[T]) Size() int {
+	e.Purge()
+	return e.order.Size()
+}
+
+// Empty - returns true if the set contains no keys which are not expired, false otherwise
+func (e *Expiring[T]) Empty() bool {
+	return e.Size() == 0
+}
+
+// Slice - returns the keys of the set which are not expired as a slice, in eviction order
+func (e *Expiring[T]) Slice() []T {
+	e.Purge()
+	return e.order.Slice()
+}
+
+// ForEach - iterates over the keys of the set which are not expired in eviction order,
+// calling the given function `f` for each key
+func (e *Expiring[T]) ForEach(f func(T)) {
+	for _, key := range e.Slice() {
+		f(key)
+	}
+}
+
+// live - returns true if the key exists and is not expired, removing it (and firing the eviction
+// callbacks) if it is expired
+func (e *Expiring[T]) live(key T, now time.Time) bool {
+	expires, exists := e.expires[key]
+	if !exists {
+		return false
+	}
+
+	if !expires.IsZero() && !now.Before(expires) {
+		e.drop(key)
+		e.notify(key, Expired)
+		return false
+	}
+
+	return true
+}
+
+// purge - removes all the expired keys (firing the eviction callbacks), recomputes the earliest expiration
+// time of the remaining keys, and returns the number of removed keys
+func (e *Expiring[T]) purge(now time.Time) int {
+	purged := 0
+	e.earliest = time.Time{}
+
+	for _, key := range e.order.Slice() {
+		if !e.live(key, now) {
+			purged++
+			continue
+		}
+
+		if expires := e.expires[key]; !expires.IsZero() && (e.earliest.IsZero() || expires.Before(e.earliest)) {
+			e.earliest = expires
+		}
+	}
+
+	return purged
+}
+
+// shrink - removes the expired keys, then evicts live keys from the front of the eviction order,
+// until the set is within its capacity
+//
+// NOTE: The expired keys are only searched for (in O(n)) once the earliest expiration time elapsed
+func (e *Expiring[T]) shrink(now time.Time) {
+	if e.config.Capacity <= 0 || e.order.Size() <= e.config.Capacity {
+		return
+	}
+
+	if !e.earliest.IsZero() && !now.Before(e.earliest) {
+		e.purge(now)
+	}
+
+	for e.order.Size() > e.config.Capacity {
+		key, _ := e.order.PopFirst()
+		delete(e.expires, key)
+		e.notify(key, Evicted)
+	}
+}
+
+// drop - removes the key from the set
+func (e *Expiring[T]) drop(key T) {
+	e.order.Remove(key)
+	delete(e.expires, key)
+}
+
+// notify - fires the eviction callbacks for the given key
+func (e *Expiring[T]) notify(key T, reason Reason) {
+	for _, observer := range e.observers {
+		observer(key, reason)
+	}
+}
@@ -0,0 +1,149 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock - a manually advanced clock
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(duration time.Duration) {
+	c.now = c.now.Add(duration)
+}
+
+func TestExpiring_TTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	set := NewExpiring[string](ExpiringConfig{TTL: time.Minute, Clock: clock.Now})
+
+	expired := make([]string, 0)
+	set.OnEvict(func(key string, reason Reason) {
+		assert.Equal(t, Expired, reason)
+		expired = append(expired, key)
+	})
+
+	assert.True(t, set.Insert("a"))
+	assert.False(t, set.Insert("a"))
+	assert.True(t, set.InsertTTL("b", 2*time.Minute))
+	assert.True(t, set.InsertTTL("c", 0))
+
+	clock.Advance(30 * time.Second)
+	ttl, ok := set.TTL("a")
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, ttl)
+
+	clock.Advance(30 * time.Second)
+	assert.False(t, set.Has("a"))
+	assert.True(t, set.Has("b"))
+	assert.Equal(t, []string{"a"}, expired)
+
+	clock.Advance(time.Hour)
+	assert.Equal(t, 1, set.Size())
+	assert.Equal(t, []string{"a", "b"}, expired)
+
+	ttl, ok = set.TTL("c")
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), ttl)
+
+	assert.True(t, set.Insert("a"))
+	assert.True(t, set.Remove("a"))
+	assert.False(t, set.Remove("a"))
+	assert.Equal(t, []string{"c"}, set.Slice())
+}
+
+func TestExpiring_FIFO(t *testing.T) {
+	set := NewExpiring[int](ExpiringConfig{Capacity: 3, Eviction: FIFO})
+
+	evicted := make([]int, 0)
+	set.OnEvict(func(key int, reason Reason) {
+		assert.Equal(t, Evicted, reason)
+		evicted = append(evicted, key)
+	})
+
+	set.Insert(1)
+	set.Insert(2)
+	set.Insert(3)
+	assert.True(t, set.Has(1))
+	set.Insert(4)
+	set.Insert(2)
+	set.Insert(5)
+
+	assert.Equal(t, []int{1, 2}, evicted)
+	assert.Equal(t, []int{3, 4, 5}, set.Slice())
+}
+
+func TestExpiring_LRU(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	set := NewExpiring[int](ExpiringConfig{TTL: time.Minute, Capacity: 3, Eviction: LRU, Clock: clock.Now})
+
+	reasons := make(map[int]Reason)
+	set.OnEvict(func(key int, reason Reason) {
+		reasons[key] = reason
+	})
+
+	set.Insert(1)
+	set.Insert(2)
+	set.Insert(3)
+	assert.True(t, set.Has(1))
+	set.Insert(4)
+
+	assert.Equal(t, map[int]Reason{2: Evicted}, reasons)
+	assert.Equal(t, []int{3, 1, 4}, set.Slice())
+
+	clock.Advance(time.Minute)
+	set.Insert(5)
+	assert.Equal(t, Expired, reasons[3])
+	assert.Equal(t, []int{5}, set.Slice())
+	assert.Equal(t, "evicted", Evicted.String())
+}
+
+func TestExpiring_ExpiredBeforeEvicted(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	set := NewExpiring[string](ExpiringConfig{Capacity: 3, Clock: clock.Now})
+
+	reasons := make(map[string]Reason)
+	set.OnEvict(func(key string, reason Reason) {
+		reasons[key] = reason
+	})
+
+	assert.True(t, set.Insert("a"))
+	assert.True(t, set.Insert("b"))
+	assert.True(t, set.InsertTTL("c", time.Minute))
+
+	clock.Advance(time.Minute)
+	assert.True(t, set.Insert("d"))
+
+	assert.Equal(t, map[string]Reason{"c": Expired}, reasons)
+	assert.Equal(t, []string{"a", "b", "d"}, set.Slice())
+
+	assert.True(t, set.Insert("e"))
+	assert.Equal(t, map[string]Reason{"c": Expired, "a": Evicted}, reasons)
+	assert.Equal(t, []string{"b", "d", "e"}, set.Slice())
+}
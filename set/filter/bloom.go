@@ -0,0 +1,257 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package filter
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/andrei-cosmin/sandata/mathutil"
+	"github.com/bits-and-blooms/bitset"
+)
+
+// bloomHeader - the size of the binary header of a Bloom filter (hash count and insertion count)
+const bloomHeader = 16
+
+// bitsHeader - the size of the binary header of the bits of a Bloom filter (bit count)
+const bitsHeader = 8
+
+// maxHashes - the maximum number of hash functions of a Bloom filter (more only slow it down, without
+// a meaningful gain in false-positive rate)
+const maxHashes = 64
+
+// NewBloom - creates a new Bloom filter sized for the given number of keys and false-positive rate
+//
+//	m = -n * ln(p) / ln(2)^2 bits, k = m / n * ln(2) hash functions
+func NewBloom[T any](n uint, falsePositiveRate float64, hash Hash[T]) *Bloom[T] {
+	n = max(1, n)
+	falsePositiveRate = min(max(falsePositiveRate, math.SmallestNonzeroFloat64), 1)
+
+	bits := math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	bits = max(bits, 1)
+	hashes := math.Round(bits / float64(n) * math.Ln2)
+
+	return NewBloomWith(uint(bits), uint(max(hashes, 1)), hash)
+}
+
+// NewBloomWith - creates a new Bloom filter with the given number of bits and hash functions
+//
+// NOTE: The number of hash functions is clamped to [1, 64]
+func NewBloomWith[T any](bits uint, hashes uint, hash Hash[T]) *Bloom[T] {
+	return &Bloom[T]{
+		bits:   bitset.New(max(bits, 1)),
+		hashes: min(max(hashes, 1), maxHashes),
+		hash:   hash,
+	}
+}
+
+// Bloom - represents a Bloom filter, which can answer whether a key was (probably) inserted, or
+// (certainly) was not inserted
+//   - bits *bitset.BitSet - the bits of the filter
+//   - hashes uint - the number of bits set for each key
+//   - count uint - the number of insertions which modified the filter
+//   - hash Hash[T] - the hash function of the keys
+type Bloom[T any] struct {
+	bits   *bitset.BitSet
+	hashes uint
+	count  uint
+	hash   Hash[T]
+}
+
+// Insert - inserts the key into the filter, and returns
+// true if the filter was modified (key was certainly not present before), false otherwise
+func (b *Bloom[T]) Insert(key T) bool {
+	modified := false
+	h1, h2 := b.locations(key)
+
+	for index := range b.hashes {
+		location := b.location(h1, h2, index)
+		if !b.bits.Test(location) {
+			b.bits.Set(location)
+			modified = true
+		}
+	}
+
+	if modified {
+		b.count++
+	}
+	return modified
+}
+
+// InsertSlice - inserts each key from the given slice into the filter, and returns
+// true if the filter was modified (at least once), false otherwise
+func (b *Bloom[T]) InsertSlice(keys []T) bool {
+	modified := false
+
+	for _, key := range keys {
+		if b.Insert(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Has - returns true if the key was probably inserted into the filter, false if it certainly was not
+func (b *Bloom[T]) Has(key T) bool {
+	h1, h2 := b.locations(key)
+
+	for index := range b.hashes {
+		if !b.bits.Test(b.location(h1, h2, index)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Size - returns the (approximate) number of keys inserted into the filter
+func (b *Bloom[T]) Size() uint {
+	return b.count
+}
+
+// Empty - returns true if no key was inserted into the filter, false otherwise
+func (b *Bloom[T]) Empty() bool {
+	return b.bits.None()
+}
+
+// Cap - returns the number of bits of the filter
+func (b *Bloom[T]) Cap() uint {
+	return b.bits.Len()
+}
+
+// Hashes - returns the number of hash functions (bits set for each key) of the filter
+func (b *Bloom[T]) Hashes() uint {
+	return b.hashes
+}
+
+// FalsePositiveRate - returns the estimated false-positive rate of the filter, given its current fill
+//
+//	p = ( count(bits) / m ) ^ k
+func (b *Bloom[T]) FalsePositiveRate() float64 {
+	fill := float64(b.bits.Count()) / float64(b.bits.Len())
+	return math.Pow(fill, float64(b.hashes))
+}
+
+// Union - returns a new filter containing the keys of both filters
+//
+// NOTE: The filters must have the same number of bits and hash functions (and use the same hash function),
+// otherwise ErrIncompatible is returned
+func (b *Bloom[T]) Union(other *Bloom[T]) (*Bloom[T], error) {
+	if !b.compatible(other) {
+		return nil, ErrIncompatible
+	}
+
+	return &Bloom[T]{
+		bits:   b.bits.Union(other.bits),
+		hashes: b.hashes,
+		count:  b.count + other.count,
+		hash:   b.hash,
+	}, nil
+}
+
+// InsertFilter - inserts the keys of the given filter into the current filter
+//
+// NOTE: The filters must have the same number of bits and hash functions (and use the same hash function),
+// otherwise ErrIncompatible is returned
+func (b *Bloom[T]) InsertFilter(other *Bloom[T]) error {
+	if !b.compatible(other) {
+		return ErrIncompatible
+	}
+
+	b.bits.InPlaceUnion(other.bits)
+	b.count += other.count
+	return nil
+}
+
+// Copy - returns a copy of the current filter
+func (b *Bloom[T]) Copy() *Bloom[T] {
+	return &Bloom[T]{
+		bits:   b.bits.Clone(),
+		hashes: b.hashes,
+		count:  b.count,
+		hash:   b.hash,
+	}
+}
+
+// MarshalBinary - encodes the filter in a binary form
+//
+//	[hashes uint64][count uint64][bits (bitset binary form)]
+func (b *Bloom[T]) MarshalBinary() ([]byte, error) {
+	bits, err := b.bits.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, bloomHeader, bloomHeader+len(bits))
+	binary.BigEndian.PutUint64(data, uint64(b.hashes))
+	binary.BigEndian.PutUint64(data[8:], uint64(b.count))
+	return append(data, bits...), nil
+}
+
+// UnmarshalBinary - decodes the filter from its binary form, replacing its contents
+//
+// NOTE: The hash function of the filter is kept, and must be the one used by the encoded filter
+func (b *Bloom[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < bloomHeader+bitsHeader {
+		return ErrInvalidData
+	}
+
+	hashes := uint(binary.BigEndian.Uint64(data))
+	if hashes == 0 || hashes > maxHashes {
+		return ErrInvalidData
+	}
+
+	// the declared bit count must match the encoded words, before the bits are allocated
+	length := binary.BigEndian.Uint64(data[bloomHeader:])
+	words := length/64 + min(length%64, 1)
+	if payload := uint64(len(data) - bloomHeader - bitsHeader); payload%8 != 0 || words != payload/8 {
+		return ErrInvalidData
+	}
+
+	bits := &bitset.BitSet{}
+	if err := bits.UnmarshalBinary(data[bloomHeader:]); err != nil {
+		return err
+	}
+	if bits.Len() == 0 {
+		return ErrInvalidData
+	}
+
+	b.bits = bits
+	b.hashes = hashes
+	b.count = uint(binary.BigEndian.Uint64(data[8:]))
+	return nil
+}
+
+// compatible - returns true if the filters have the same number of bits and hash functions
+func (b *Bloom[T]) compatible(other *Bloom[T]) bool {
+	return b.bits.Len() == other.bits.Len() && b.hashes == other.hashes
+}
+
+// locations - returns the two hashes of the key, combined to obtain the locations of its bits
+func (b *Bloom[T]) locations(key T) (uint64, uint64) {
+	h1 := b.hash(key)
+	return h1, mathutil.Mix(h1) | 1
+}
+
+// location - returns the location of the bit of the given index (double hashing: h1 + index * h2)
+func (b *Bloom[T]) location(h1, h2 uint64, index uint) uint {
+	return uint((h1 + uint64(index)*h2) % uint64(b.bits.Len()))
+}
@@ -0,0 +1,115 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package filter
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	defaultSize    = 10000
+	maxRandomValue = 1000000
+	falsePositive  = 0.01
+)
+
+func TestBloom_Has(t *testing.T) {
+	values := testutil.RandomUInts(2*defaultSize, maxRandomValue)
+	inserted, absent := values[:defaultSize], values[defaultSize:]
+
+	filter := NewBloom(defaultSize, falsePositive, HashInteger[uint])
+	assert.True(t, filter.Empty())
+	assert.Equal(t, uint(7), filter.Hashes())
+
+	filter.InsertSlice(inserted)
+	for _, value := range inserted {
+		assert.True(t, filter.Has(value))
+	}
+
+	positives := 0
+	for _, value := range absent {
+		if filter.Has(value) {
+			positives++
+		}
+	}
+
+	assert.Less(t, float64(positives)/defaultSize, 2*falsePositive)
+	assert.InDelta(t, falsePositive, filter.FalsePositiveRate(), falsePositive)
+}
+
+func TestBloom_Union(t *testing.T) {
+	f1 := NewBloom(defaultSize, falsePositive, HashString)
+	f2 := NewBloom(defaultSize, falsePositive, HashString)
+
+	assert.True(t, f1.Insert("a"))
+	assert.False(t, f1.Insert("a"))
+	f2.Insert("b")
+
+	union, err := f1.Union(f2)
+	assert.NoError(t, err)
+	assert.True(t, union.Has("a"))
+	assert.True(t, union.Has("b"))
+	assert.False(t, f1.Has("b"))
+
+	_, err = f1.Union(NewBloom(2*defaultSize, falsePositive, HashString))
+	assert.ErrorIs(t, err, ErrIncompatible)
+
+	assert.NoError(t, f1.InsertFilter(f2))
+	assert.True(t, f1.Has("b"))
+}
+
+func TestBloom_Binary(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+	filter := NewBloom(defaultSize, falsePositive, HashInteger[uint])
+	filter.InsertSlice(values)
+
+	data, err := filter.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := NewBloom(1, 0.5, HashInteger[uint])
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, filter.Cap(), decoded.Cap())
+	assert.Equal(t, filter.Hashes(), decoded.Hashes())
+	assert.Equal(t, filter.Size(), decoded.Size())
+	for _, value := range values {
+		assert.True(t, decoded.Has(value))
+	}
+
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data[:4]), ErrInvalidData)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data[:len(data)-8]), ErrInvalidData)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data[:len(data)-3]), ErrInvalidData)
+
+	oversized := make([]byte, bloomHeader+bitsHeader)
+	binary.BigEndian.PutUint64(oversized, 1)
+	binary.BigEndian.PutUint64(oversized[bloomHeader:], 1<<62)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(oversized), ErrInvalidData)
+
+	binary.BigEndian.PutUint64(data[bloomHeader:], uint64(filter.Cap())+64)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data), ErrInvalidData)
+	assert.Equal(t, filter.Cap(), decoded.Cap())
+	binary.BigEndian.PutUint64(data[bloomHeader:], uint64(filter.Cap()))
+
+	binary.BigEndian.PutUint64(data, 1<<63)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data), ErrInvalidData)
+	assert.Equal(t, filter.Hashes(), decoded.Hashes())
+}
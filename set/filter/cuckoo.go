@@ -0,0 +1,361 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package filter
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+
+	"github.com/andrei-cosmin/sandata/mathutil"
+)
+
+const (
+	// bucketSize - the number of fingerprints stored in each bucket of a Cuckoo filter
+	bucketSize = 4
+
+	// maxKicks - the number of relocations attempted before a Cuckoo filter is considered full
+	maxKicks = 500
+
+	// cuckooLoad - the expected load factor of a Cuckoo filter, used for sizing
+	cuckooLoad = 0.95
+
+	// cuckooHeader - the size of the binary header of a Cuckoo filter (bucket count, count, victim)
+	cuckooHeader = 24
+)
+
+// fingerprint - represents the fingerprint of a key stored in a Cuckoo filter (0 marks an empty slot)
+type fingerprint uint16
+
+// bucket - represents a bucket of a Cuckoo filter
+type bucket [bucketSize]fingerprint
+
+// victim - represents a fingerprint which could not be placed after the maximum number of relocations
+//   - fingerprint fingerprint - the fingerprint (0 if there is no victim)
+//   - index uint64 - one of the two buckets of the fingerprint
+type victim struct {
+	fingerprint fingerprint
+	index       uint64
+}
+
+// NewCuckoo - creates a new Cuckoo filter sized for the given number of keys
+//
+// NOTE: The false-positive rate is about 8 / 2^16 (0.012%), due to the 16-bit fingerprints
+func NewCuckoo[T any](n uint, hash Hash[T]) *Cuckoo[T] {
+	buckets := uint(float64(n)/bucketSize/cuckooLoad) + 1
+	if buckets&(buckets-1) != 0 {
+		buckets = mathutil.NextPowerOfTwo(buckets)
+	}
+
+	return &Cuckoo[T]{
+		buckets: make([]bucket, buckets),
+		hash:    hash,
+	}
+}
+
+// Cuckoo - represents a Cuckoo filter, which can answer whether a key was (probably) inserted, or
+// (certainly) was not inserted, and which (unlike a Bloom filter) supports the removal of keys
+//   - buckets []bucket - the buckets of the filter (a power of two)
+//   - count uint - the number of fingerprints stored in the filter
+//   - victim victim - the fingerprint evicted by a failed insertion (if any)
+//   - hash Hash[T] - the hash function of the keys
+type Cuckoo[T any] struct {
+	buckets []bucket
+	count   uint
+	victim  victim
+	hash    Hash[T]
+}
+
+// Insert - inserts the key into the filter, and returns
+// true if the key was inserted, false if the filter is full
+//
+// NOTE: Inserting the same key twice stores it twice (so it can be removed twice)
+func (c *Cuckoo[T]) Insert(key T) bool {
+	if c.full() {
+		return false
+	}
+
+	fp, i1, _ := c.locations(key)
+	c.insert(fp, i1)
+	return true
+}
+
+// InsertSlice - inserts each key from the given slice into the filter, and returns
+// true if all keys were inserted, false if the filter became full
+func (c *Cuckoo[T]) InsertSlice(keys []T) bool {
+	for _, key := range keys {
+		if !c.Insert(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Remove - removes the key from the filter, and returns
+// true if the filter was modified (key was probably present), false otherwise
+//
+// WARNING: Only keys which were inserted should be removed, otherwise keys sharing their fingerprint
+// may be removed instead
+func (c *Cuckoo[T]) Remove(key T) bool {
+	fp, i1, i2 := c.locations(key)
+
+	for _, index := range [2]uint64{i1, i2} {
+		if c.buckets[index].remove(fp) {
+			c.count--
+			c.reinsertVictim()
+			return true
+		}
+	}
+
+	if c.victim.fingerprint == fp && (c.victim.index == i1 || c.victim.index == i2) {
+		c.victim = victim{}
+		c.count--
+		return true
+	}
+
+	return false
+}
+
+// Has - returns true if the key was probably inserted into the filter, false if it certainly was not
+func (c *Cuckoo[T]) Has(key T) bool {
+	fp, i1, i2 := c.locations(key)
+
+	if c.buckets[i1].has(fp) || c.buckets[i2].has(fp) {
+		return true
+	}
+
+	return c.victim.fingerprint == fp && (c.victim.index == i1 || c.victim.index == i2)
+}
+
+// Size - returns the number of keys stored in the filter
+func (c *Cuckoo[T]) Size() uint {
+	return c.count
+}
+
+// Empty - returns true if the filter contains no keys, false otherwise
+func (c *Cuckoo[T]) Empty() bool {
+	return c.count == 0
+}
+
+// Cap - returns the maximum number of keys the filter can store
+//
+// NOTE: Insertions usually fail before reaching the capacity (at a load of about 95%)
+func (c *Cuckoo[T]) Cap() uint {
+	return uint(len(c.buckets)) * bucketSize
+}
+
+// Union - returns a new filter containing the keys of both filters
+//
+// NOTE: The filters must have the same number of buckets (and use the same hash function), otherwise
+// ErrIncompatible is returned, and ErrFull is returned if the keys do not fit in a single filter
+func (c *Cuckoo[T]) Union(other *Cuckoo[T]) (*Cuckoo[T], error) {
+	result := c.Copy()
+	if err := result.InsertFilter(other); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// InsertFilter - inserts the keys of the given filter into the current filter
+//
+// NOTE: The filters must have the same number of buckets (and use the same hash function), otherwise
+// ErrIncompatible is returned, and ErrFull is returned if the keys do not fit in a single filter
+// (in which case the current filter is left partially updated)
+func (c *Cuckoo[T]) InsertFilter(other *Cuckoo[T]) error {
+	if len(c.buckets) != len(other.buckets) {
+		return ErrIncompatible
+	}
+
+	for index, bucket := range other.buckets {
+		for _, fp := range bucket {
+			if fp == 0 {
+				continue
+			}
+			if c.full() {
+				return ErrFull
+			}
+			c.insert(fp, uint64(index))
+		}
+	}
+
+	if other.full() {
+		if c.full() {
+			return ErrFull
+		}
+		c.insert(other.victim.fingerprint, other.victim.index)
+	}
+
+	return nil
+}
+
+// Copy - returns a copy of the current filter
+func (c *Cuckoo[T]) Copy() *Cuckoo[T] {
+	other := *c
+	other.buckets = append([]bucket(nil), c.buckets...)
+	return &other
+}
+
+// MarshalBinary - encodes the filter in a binary form
+//
+//	[buckets uint64][count uint64][victim index uint48, victim fingerprint uint16][fingerprints uint16...]
+func (c *Cuckoo[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, cuckooHeader, cuckooHeader+len(c.buckets)*bucketSize*2)
+	binary.BigEndian.PutUint64(data, uint64(len(c.buckets)))
+	binary.BigEndian.PutUint64(data[8:], uint64(c.count))
+	binary.BigEndian.PutUint64(data[16:], c.victim.index<<16|uint64(c.victim.fingerprint))
+
+	for _, bucket := range c.buckets {
+		for _, fp := range bucket {
+			data = binary.BigEndian.AppendUint16(data, uint16(fp))
+		}
+	}
+
+	return data, nil
+}
+
+// UnmarshalBinary - decodes the filter from its binary form, replacing its contents
+//
+// NOTE: The hash function of the filter is kept, and must be the one used by the encoded filter
+func (c *Cuckoo[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < cuckooHeader {
+		return ErrInvalidData
+	}
+
+	count := binary.BigEndian.Uint64(data)
+	if count == 0 || count&(count-1) != 0 || count > uint64(len(data)-cuckooHeader)/(bucketSize*2) ||
+		uint64(len(data)-cuckooHeader) != count*bucketSize*2 {
+		return ErrInvalidData
+	}
+
+	buckets := make([]bucket, count)
+	fingerprints := data[cuckooHeader:]
+	for index := range buckets {
+		for slot := range bucketSize {
+			buckets[index][slot] = fingerprint(binary.BigEndian.Uint16(fingerprints))
+			fingerprints = fingerprints[2:]
+		}
+	}
+
+	packed := binary.BigEndian.Uint64(data[16:])
+	if packed>>16 >= count {
+		return ErrInvalidData
+	}
+
+	c.buckets = buckets
+	c.count = uint(binary.BigEndian.Uint64(data[8:]))
+	c.victim = victim{fingerprint: fingerprint(packed), index: packed >> 16}
+	return nil
+}
+
+// full - returns true if the filter holds a victim fingerprint, and cannot accept new keys
+func (c *Cuckoo[T]) full() bool {
+	return c.victim.fingerprint != 0
+}
+
+// insert - inserts the fingerprint into one of its buckets (starting from the given one), relocating
+// other fingerprints if needed, and keeping the last evicted fingerprint aside when no room is found
+//
+// NOTE: The caller is responsible to ensure that the filter is not full
+func (c *Cuckoo[T]) insert(fp fingerprint, index uint64) {
+	c.count++
+
+	alternate := c.alternate(fp, index)
+	if c.buckets[index].insert(fp) || c.buckets[alternate].insert(fp) {
+		return
+	}
+
+	// Both buckets are full, fingerprints are relocated to their alternate buckets
+	if rand.IntN(2) == 0 {
+		index = alternate
+	}
+
+	for range maxKicks {
+		slot := rand.IntN(bucketSize)
+		fp, c.buckets[index][slot] = c.buckets[index][slot], fp
+
+		index = c.alternate(fp, index)
+		if c.buckets[index].insert(fp) {
+			return
+		}
+	}
+
+	// The last evicted fingerprint is kept aside, and the filter is considered full
+	c.victim = victim{fingerprint: fp, index: index}
+}
+
+// reinsertVictim - attempts to place the victim fingerprint back into the filter, after a removal
+func (c *Cuckoo[T]) reinsertVictim() {
+	if !c.full() {
+		return
+	}
+
+	fp, index := c.victim.fingerprint, c.victim.index
+	c.victim = victim{}
+	c.count--
+	c.insert(fp, index)
+}
+
+// locations - returns the fingerprint of the key, and the indexes of its two buckets
+func (c *Cuckoo[T]) locations(key T) (fingerprint, uint64, uint64) {
+	hash := c.hash(key)
+	fp := fingerprint(hash>>48%(1<<16-1) + 1)
+	index := hash & uint64(len(c.buckets)-1)
+	return fp, index, c.alternate(fp, index)
+}
+
+// alternate - returns the alternate bucket of the fingerprint stored in the given bucket
+//
+//	alternate(fp, alternate(fp, index)) = index
+func (c *Cuckoo[T]) alternate(fp fingerprint, index uint64) uint64 {
+	return (index ^ mathutil.Mix(uint64(fp))) & uint64(len(c.buckets)-1)
+}
+
+// insert - stores the fingerprint in an empty slot of the bucket, and returns false if the bucket is full
+func (b *bucket) insert(fp fingerprint) bool {
+	for slot, existing := range b {
+		if existing == 0 {
+			b[slot] = fp
+			return true
+		}
+	}
+	return false
+}
+
+// remove - removes one copy of the fingerprint from the bucket, and returns false if it was not found
+func (b *bucket) remove(fp fingerprint) bool {
+	for slot, existing := range b {
+		if existing == fp {
+			b[slot] = 0
+			return true
+		}
+	}
+	return false
+}
+
+// has - returns true if the bucket contains the fingerprint, false otherwise
+func (b *bucket) has(fp fingerprint) bool {
+	for _, existing := range b {
+		if existing == fp {
+			return true
+		}
+	}
+	return false
+}
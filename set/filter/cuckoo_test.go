@@ -0,0 +1,113 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package filter
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCuckoo_InsertRemove(t *testing.T) {
+	values := testutil.RandomUInts(2*defaultSize, maxRandomValue)
+	inserted, absent := values[:defaultSize], values[defaultSize:]
+
+	filter := NewCuckoo(defaultSize, HashInteger[uint])
+	assert.True(t, filter.InsertSlice(inserted))
+	assert.Equal(t, uint(defaultSize), filter.Size())
+
+	for _, value := range inserted {
+		assert.True(t, filter.Has(value))
+	}
+
+	positives := 0
+	for _, value := range absent {
+		if filter.Has(value) {
+			positives++
+		}
+	}
+	assert.Less(t, positives, defaultSize/1000)
+
+	for _, value := range inserted[:defaultSize/2] {
+		assert.True(t, filter.Remove(value))
+	}
+	for _, value := range inserted[defaultSize/2:] {
+		assert.True(t, filter.Has(value))
+	}
+	assert.Equal(t, uint(defaultSize/2), filter.Size())
+}
+
+func TestCuckoo_Full(t *testing.T) {
+	filter := NewCuckoo(16, HashInteger[int])
+
+	inserted := 0
+	for key := 0; filter.Insert(key); key++ {
+		inserted++
+	}
+
+	assert.LessOrEqual(t, uint(inserted), filter.Cap()+1)
+	for key := range inserted {
+		assert.True(t, filter.Has(key))
+	}
+
+	assert.True(t, filter.Remove(0))
+	assert.True(t, filter.Insert(0))
+}
+
+func TestCuckoo_Union(t *testing.T) {
+	f1 := NewCuckoo(defaultSize, HashString)
+	f2 := NewCuckoo(defaultSize, HashString)
+	f1.Insert("a")
+	f2.Insert("b")
+
+	union, err := f1.Union(f2)
+	assert.NoError(t, err)
+	assert.True(t, union.Has("a"))
+	assert.True(t, union.Has("b"))
+	assert.Equal(t, uint(2), union.Size())
+	assert.False(t, f1.Has("b"))
+
+	_, err = f1.Union(NewCuckoo(4*defaultSize, HashString))
+	assert.ErrorIs(t, err, ErrIncompatible)
+}
+
+func TestCuckoo_Binary(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+	filter := NewCuckoo(defaultSize, HashInteger[uint])
+	filter.InsertSlice(values)
+
+	data, err := filter.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := NewCuckoo(1, HashInteger[uint])
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, filter.Size(), decoded.Size())
+	for _, value := range values {
+		assert.True(t, decoded.Has(value))
+	}
+
+	assert.ErrorIs(t, decoded.UnmarshalBinary(data[:len(data)-1]), ErrInvalidData)
+
+	overflow := make([]byte, cuckooHeader)
+	binary.BigEndian.PutUint64(overflow, 1<<61)
+	assert.ErrorIs(t, decoded.UnmarshalBinary(overflow), ErrInvalidData)
+}
@@ -0,0 +1,70 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+// Package filter provides probabilistic membership filters (Bloom and Cuckoo), which answer Has queries
+// using a fraction of the memory of a set, at the cost of (bounded) false positives
+package filter
+
+import (
+	"errors"
+	"hash/fnv"
+
+	"github.com/andrei-cosmin/sandata/mathutil"
+)
+
+var (
+	// ErrIncompatible - returned when combining filters with different parameters
+	ErrIncompatible = errors.New("filter: incompatible filters")
+
+	// ErrFull - returned when a filter has no room left for a key
+	ErrFull = errors.New("filter: filter is full")
+
+	// ErrInvalidData - returned when decoding malformed binary data
+	ErrInvalidData = errors.New("filter: invalid data")
+)
+
+// Hash - represents a hash function for the keys of a filter
+//
+// NOTE: Filters which are serialized, or combined with each other, must use the same (deterministic)
+// hash function, so the hashes must not depend on a per-process seed (such as maphash)
+type Hash[T any] func(key T) uint64
+
+// Integer - constraint for the integer types supported by HashInteger
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// HashString - returns the (deterministic) 64-bit FNV-1a hash of the given string
+func HashString(key string) uint64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(key))
+	return mathutil.Mix(hash.Sum64())
+}
+
+// HashBytes - returns the (deterministic) 64-bit FNV-1a hash of the given bytes
+func HashBytes(key []byte) uint64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write(key)
+	return mathutil.Mix(hash.Sum64())
+}
+
+// HashInteger - returns the (deterministic) hash of the given integer
+func HashInteger[T Integer](key T) uint64 {
+	return mathutil.Mix(uint64(key))
+}
@@ -0,0 +1,287 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"hash/maphash"
+	"iter"
+	"reflect"
+	"runtime"
+	"slices"
+	"sync"
+	"weak"
+
+	"github.com/andrei-cosmin/sandata/mathutil"
+)
+
+// fingerprintSeed - the seed used for hashing the keys of the fingerprints of all sets
+var fingerprintSeed = maphash.MakeSeed()
+
+// freezers - the Freezer used by Freeze, for each key type (reflect.Type -> *Freezer[T])
+var freezers sync.Map
+
+// Fingerprint - returns an order-independent hash of the keys of the set, so that equal sets have
+// equal fingerprints (and different sets most likely have different fingerprints)
+//
+// NOTE: The fingerprint is only stable within a process (see FingerprintFunc for a stable one)
+func (s *Set[T]) Fingerprint() uint64 {
+	return s.FingerprintFunc(func(key T) uint64 {
+		return maphash.Comparable(fingerprintSeed, key)
+	})
+}
+
+// FingerprintFunc - returns an order-independent hash of the keys of the set, using the given hash
+// function for the keys
+//
+//	fingerprint = Σ mix( hash(key) ) + size
+func (s *Set[T]) FingerprintFunc(hash func(T) uint64) uint64 {
+	fingerprint := uint64(len(s.keys))
+
+	for key := range s.keys {
+		fingerprint += mathutil.Mix(hash(key))
+	}
+
+	return fingerprint
+}
+
+// Freeze - returns an immutable Frozen copy of the given set, interned in a package-level Freezer
+// (one for each key type), so equal sets are frozen to the same value
+//
+// NOTE: Frozen values returned by Freeze compare by contents with ==, so they can be used directly as map keys
+func Freeze[T comparable](s *Set[T]) Frozen[T] {
+	freezer, _ := freezers.LoadOrStore(reflect.TypeFor[T](), NewFreezer[T]())
+	return freezer.(*Freezer[T]).Freeze(s)
+}
+
+// Frozen - represents an immutable set, which carries its fingerprint so it can be hashed and compared
+// cheaply, and used as a key (of a map, or of a Hashed set)
+//   - set *Set[T] - the keys of the set (nil for the zero value, and for every empty set)
+//   - fingerprint uint64 - the fingerprint of the set
+//
+// NOTE: Values interned by the same Freezer (such as all the values returned by Freeze) are equal (==)
+// if and only if their sets are equal; values of different Freezers must be compared with Equal
+type Frozen[T comparable] struct {
+	set         *Set[T]
+	fingerprint uint64
+}
+
+// Has - returns true if key exists in the set, false otherwise
+func (f Frozen[T]) Has(key T) bool {
+	_, exists := f.keys()[key]
+	return exists
+}
+
+// HasSlice - returns true if all keys are present in the set, false otherwise
+//
+// NOTE: This method will return true for an empty slice
+func (f Frozen[T]) HasSlice(keys []T) bool {
+	for _, key := range keys {
+		if !f.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Size - returns the cardinality of the set
+func (f Frozen[T]) Size() int {
+	return len(f.keys())
+}
+
+// Empty - returns true if the set contains no elements, false otherwise
+func (f Frozen[T]) Empty() bool {
+	return f.Size() == 0
+}
+
+// Fingerprint - returns the fingerprint of the set (see Set.Fingerprint)
+func (f Frozen[T]) Fingerprint() uint64 {
+	if f.set == nil {
+		return New[T](0).Fingerprint()
+	}
+	return f.fingerprint
+}
+
+// Equal - returns true if the sets contain the same keys, false otherwise
+func (f Frozen[T]) Equal(other Frozen[T]) bool {
+	if f.set == other.set {
+		return true
+	}
+	if f.Fingerprint() != other.Fingerprint() || f.Size() != other.Size() {
+		return false
+	}
+
+	for key := range other.keys() {
+		if !f.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Set - returns a mutable copy of the set
+func (f Frozen[T]) Set() *Set[T] {
+	if f.set == nil {
+		return New[T](0)
+	}
+	return f.set.Copy()
+}
+
+// Slice - returns a copy of the set as a slice
+func (f Frozen[T]) Slice() []T {
+	return f.Set().Slice()
+}
+
+// All - returns an iterator over the keys of the set
+func (f Frozen[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for key := range f.keys() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// ForEach - iterates over the set, calling the given function `f` for each key
+func (f Frozen[T]) ForEach(fn func(T)) {
+	for key := range f.keys() {
+		fn(key)
+	}
+}
+
+// String - returns a string representation of the set (see Set.String)
+func (f Frozen[T]) String() string {
+	return f.Set().String()
+}
+
+// keys - returns the keys of the set (nil for the zero value)
+func (f Frozen[T]) keys() map[T]nothing {
+	if f.set == nil {
+		return nil
+	}
+	return f.set.keys
+}
+
+// FrozenHasher - returns a Hasher for Frozen sets, which hashes them by their fingerprint and compares
+// them by their contents, so they can be stored in a Hashed set
+func FrozenHasher[T comparable]() Hasher[Frozen[T]] {
+	return frozenHasher[T]{}
+}
+
+// frozenHasher - represents the Hasher of Frozen sets
+type frozenHasher[T comparable] struct{}
+
+// Hash - returns the fingerprint of the set
+func (frozenHasher[T]) Hash(key Frozen[T]) uint64 {
+	return key.Fingerprint()
+}
+
+// Equal - returns true if the two sets contain the same keys, false otherwise
+func (frozenHasher[T]) Equal(a, b Frozen[T]) bool {
+	return a.Equal(b)
+}
+
+// NewFreezer - creates a new empty Freezer
+func NewFreezer[T comparable]() *Freezer[T] {
+	return &Freezer[T]{
+		frozen: make(map[uint64][]weak.Pointer[Set[T]]),
+	}
+}
+
+// Freezer - represents a thread-safe interning pool of Frozen sets, which returns the same Frozen value
+// for equal sets, so the values it returns can be compared with == and used directly as map keys
+//   - mutex sync.Mutex - the lock guarding the pool
+//   - frozen map[uint64][]weak.Pointer[Set[T]] - the interned sets, grouped by their fingerprints
+//
+// NOTE: The pool only holds weak pointers, so an interned set is released once no Frozen value refers to it
+type Freezer[T comparable] struct {
+	mutex  sync.Mutex
+	frozen map[uint64][]weak.Pointer[Set[T]]
+}
+
+// interned - represents an entry of a Freezer, released when its set is garbage collected
+//   - fingerprint uint64 - the fingerprint of the set
+//   - pointer weak.Pointer[Set[T]] - the weak pointer to the set
+type interned[T comparable] struct {
+	fingerprint uint64
+	pointer     weak.Pointer[Set[T]]
+}
+
+// Freeze - returns the interned Frozen value of the given set, interning a copy of it when no
+// equal set is interned
+//
+// NOTE: Every empty set is frozen to the zero Frozen value
+func (f *Freezer[T]) Freeze(s *Set[T]) Frozen[T] {
+	if s.Empty() {
+		return Frozen[T]{}
+	}
+
+	fingerprint := s.Fingerprint()
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, pointer := range f.frozen[fingerprint] {
+		if set := pointer.Value(); set != nil && set.Equal(s) {
+			return Frozen[T]{set: set, fingerprint: fingerprint}
+		}
+	}
+
+	set := s.Copy()
+	pointer := weak.Make(set)
+	f.frozen[fingerprint] = append(f.frozen[fingerprint], pointer)
+	runtime.AddCleanup(set, f.release, interned[T]{fingerprint: fingerprint, pointer: pointer})
+
+	return Frozen[T]{set: set, fingerprint: fingerprint}
+}
+
+// Size - returns the number of distinct (non-empty) sets interned by the Freezer
+func (f *Freezer[T]) Size() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	size := 0
+	for _, pointers := range f.frozen {
+		for _, pointer := range pointers {
+			if pointer.Value() != nil {
+				size++
+			}
+		}
+	}
+	return size
+}
+
+// release - removes the entry of a garbage collected set from the pool
+func (f *Freezer[T]) release(entry interned[T]) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	pointers := slices.DeleteFunc(f.frozen[entry.fingerprint], func(pointer weak.Pointer[Set[T]]) bool {
+		return pointer == entry.pointer
+	})
+
+	if len(pointers) == 0 {
+		delete(f.frozen, entry.fingerprint)
+	} else {
+		f.frozen[entry.fingerprint] = pointers
+	}
+}
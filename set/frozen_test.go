@@ -0,0 +1,119 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet_Fingerprint(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+
+	reversed := New[uint](defaultSize)
+	for index := len(values) - 1; index >= 0; index-- {
+		reversed.Insert(values[index])
+	}
+
+	assert.Equal(t, From(values).Fingerprint(), reversed.Fingerprint())
+	assert.NotEqual(t, From(values).Fingerprint(), From(values[1:]).Fingerprint())
+	assert.NotEqual(t, New[uint](0).Fingerprint(), From([]uint{0}).Fingerprint())
+
+	identity := func(key uint) uint64 { return uint64(key) }
+	assert.Equal(t, From(values).FingerprintFunc(identity), reversed.FingerprintFunc(identity))
+}
+
+func TestFrozen(t *testing.T) {
+	source := From([]string{"read", "write"})
+	frozen := Freeze(source)
+	source.Insert("admin")
+
+	assert.Equal(t, 2, frozen.Size())
+	assert.False(t, frozen.Has("admin"))
+	assert.True(t, frozen.HasSlice([]string{"read", "write"}))
+	assert.Equal(t, "{read, write}", frozen.String())
+
+	other := Freeze(From([]string{"write", "read"}))
+	assert.True(t, frozen.Equal(other))
+	assert.True(t, frozen == other)
+	assert.False(t, frozen.Equal(Freeze(source)))
+	assert.True(t, frozen != Freeze(source))
+
+	var zero Frozen[string]
+	assert.True(t, zero.Empty())
+	assert.True(t, zero.Equal(Freeze(New[string](0))))
+	assert.True(t, zero == Freeze(New[string](0)))
+	assert.False(t, zero.Has("read"))
+
+	thawed := frozen.Set()
+	thawed.Insert("admin")
+	assert.Equal(t, 2, frozen.Size())
+}
+
+func TestFrozen_MapKey(t *testing.T) {
+	owners := make(map[Frozen[int]]int)
+	for owner, bundle := range [][]int{{1, 2, 3}, {3, 2, 1}, {2, 3, 1, 2}, {1, 2}} {
+		owners[Freeze(From(bundle))] = owner
+	}
+
+	assert.Len(t, owners, 2)
+	assert.Equal(t, 2, owners[Freeze(From([]int{1, 2, 3}))])
+	assert.Equal(t, 3, owners[Freeze(From([]int{2, 1}))])
+}
+
+func TestFrozen_SetOfSets(t *testing.T) {
+	bundles := NewHashed(FrozenHasher[string](), 0)
+
+	assert.True(t, bundles.Insert(Freeze(From([]string{"read", "write"}))))
+	assert.False(t, bundles.Insert(Freeze(From([]string{"write", "read"}))))
+	assert.True(t, bundles.Insert(Freeze(From([]string{"read"}))))
+	assert.Equal(t, 2, bundles.Size())
+}
+
+func TestFreezer(t *testing.T) {
+	freezer := NewFreezer[string]()
+	f1 := freezer.Freeze(From([]string{"read", "write"}))
+	f2 := freezer.Freeze(From([]string{"write", "read"}))
+	f3 := freezer.Freeze(From([]string{"read"}))
+
+	assert.True(t, f1 == f2)
+	assert.True(t, f1 != f3)
+	assert.Equal(t, 2, freezer.Size())
+
+	owners := map[Frozen[string]]string{f1: "admin"}
+	assert.Equal(t, "admin", owners[freezer.Freeze(From([]string{"write", "read"}))])
+	runtime.KeepAlive(f3)
+}
+
+func TestFreezer_Release(t *testing.T) {
+	freezer := NewFreezer[int]()
+	for index := range 100 {
+		freezer.Freeze(From([]int{index}))
+	}
+
+	assert.Eventually(t, func() bool {
+		runtime.GC()
+		return freezer.Size() == 0
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,386 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"errors"
+	"iter"
+	"slices"
+
+	"github.com/andrei-cosmin/sandata/mathutil"
+)
+
+// ErrNoHasher - returned when decoding into a Hashed set which was not created with a Hasher
+var ErrNoHasher = errors.New("set: hashed set without hasher")
+
+// Hasher - interface for hashing and comparing keys of a type which is not comparable
+//
+// NOTE: Equal keys must have equal hashes
+type Hasher[T any] interface {
+
+	// Hash - returns the hash of the key
+	Hash(key T) uint64
+
+	// Equal - returns true if the two keys are equal, false otherwise
+	Equal(a, b T) bool
+}
+
+// NewHashed - creates a new Hashed set using the given hasher, with pre-allocated memory for the given size
+func NewHashed[T any](hasher Hasher[T], size int) *Hashed[T] {
+	return &Hashed[T]{
+		hasher:  hasher,
+		buckets: make(map[uint64][]T, size),
+	}
+}
+
+// HashedFrom - creates a new Hashed set using the given hasher, containing each key in the given slice
+func HashedFrom[T any](hasher Hasher[T], keys []T) *Hashed[T] {
+	s := NewHashed(hasher, len(keys))
+	s.InsertSlice(keys)
+	return s
+}
+
+// Hashed - represents a set structure for keys which are not comparable (slices, chains, structs with slices),
+// hashed and compared by a Hasher, and stored in buckets of keys with equal hashes
+//   - hasher Hasher[T] - the hasher of the keys
+//   - buckets map[uint64][]T - the keys of the set, grouped by their hashes
+//   - size int - the cardinality of the set
+type Hashed[T any] struct {
+	hasher  Hasher[T]
+	buckets map[uint64][]T
+	size    int
+}
+
+// Insert - inserts the key into the set, and returns
+// true if the set was modified (key didn't exist before), false otherwise
+func (s *Hashed[T]) Insert(key T) bool {
+	hash := s.hasher.Hash(key)
+	if s.indexOf(hash, key) >= 0 {
+		return false
+	}
+
+	s.buckets[hash] = append(s.buckets[hash], key)
+	s.size++
+	return true
+}
+
+// InsertSlice - inserts each key from the given slice into the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Hashed[T]) InsertSlice(keys []T) bool {
+	modified := false
+
+	for _, item := range keys {
+		if s.Insert(item) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// InsertSet - inserts each element of the given set into the current set, and returns
+// true if the current set was modified (at least once), false otherwise
+func (s *Hashed[T]) InsertSet(other *Hashed[T]) bool {
+	modified := false
+
+	for key := range other.All() {
+		if s.Insert(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Remove - removes the key from the set, and returns
+// true if the set was modified (key existed before), false otherwise
+func (s *Hashed[T]) Remove(key T) bool {
+	hash := s.hasher.Hash(key)
+	index := s.indexOf(hash, key)
+	if index < 0 {
+		return false
+	}
+
+	bucket := s.buckets[hash]
+	if len(bucket) == 1 {
+		delete(s.buckets, hash)
+	} else {
+		last := len(bucket) - 1
+		bucket[index] = bucket[last]
+		clear(bucket[last:])
+		s.buckets[hash] = bucket[:last]
+	}
+
+	s.size--
+	return true
+}
+
+// RemoveSlice - removes each key in keys from the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Hashed[T]) RemoveSlice(keys []T) bool {
+	modified := false
+
+	for _, item := range keys {
+		if s.Remove(item) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// RemoveSet - removes each element of the given set from the current set, and returns
+// true if the current set was modified (at least once), false otherwise
+func (s *Hashed[T]) RemoveSet(other *Hashed[T]) bool {
+	modified := false
+
+	for _, key := range other.Slice() {
+		if s.Remove(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Has - returns true if key exists in the set, false otherwise
+func (s *Hashed[T]) Has(key T) bool {
+	return s.indexOf(s.hasher.Hash(key), key) >= 0
+}
+
+// HasSlice - returns true if all keys are present in the set, false otherwise
+//
+// NOTE: This method will return true for an empty slice
+func (s *Hashed[T]) HasSlice(keys []T) bool {
+	for _, key := range keys {
+		if !s.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasSet - returns true if all keys from the other set are present in the current set, false otherwise
+//
+// NOTE: This method will return true for an empty given set
+func (s *Hashed[T]) HasSet(other *Hashed[T]) bool {
+	if other.size > s.size {
+		return false
+	}
+
+	for key := range other.All() {
+		if !s.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FilterFunc - filters the set using the given filter function, and returns
+// true if the set was modified, false otherwise
+func (s *Hashed[T]) FilterFunc(filter func(T) bool) bool {
+	modified := false
+
+	for _, key := range s.Slice() {
+		if !filter(key) && s.Remove(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Size - returns the cardinality of the set
+func (s *Hashed[T]) Size() int {
+	return s.size
+}
+
+// Empty - returns true if the set contains no elements, false otherwise
+func (s *Hashed[T]) Empty() bool {
+	return s.size == 0
+}
+
+// Union - returns new set representing the union of the current and given sets
+//
+//	result = set.union(other) =>  result <- set ∪ other
+func (s *Hashed[T]) Union(other *Hashed[T]) *Hashed[T] {
+	result := NewHashed(s.hasher, max(s.Size(), other.Size()))
+
+	result.InsertSet(s)
+	result.InsertSet(other)
+
+	return result
+}
+
+// Difference - returns a set representing the difference between the current and given sets
+//
+//	result = set.difference(other) =>  result <- set \ other
+func (s *Hashed[T]) Difference(other *Hashed[T]) *Hashed[T] {
+	result := NewHashed(s.hasher, max(0, s.Size()-other.Size()))
+
+	for key := range s.All() {
+		if !other.Has(key) {
+			result.Insert(key)
+		}
+	}
+
+	return result
+}
+
+// Intersect - returns a set representing the intersection of the current and given set
+//
+//	result = set.intersect(other) =>  result <- set ∩ other
+func (s *Hashed[T]) Intersect(other *Hashed[T]) *Hashed[T] {
+	result := NewHashed(s.hasher, 0)
+	s1, s2 := s, other
+	if s2.size < s1.size {
+		s1, s2 = s2, s1
+	}
+
+	for key := range s1.All() {
+		if s2.Has(key) {
+			result.Insert(key)
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifference - returns a set representing the keys present in exactly one of the current and given sets
+//
+//	result = set.symmetricDifference(other) =>  result <- (set \ other) ∪ (other \ set)
+func (s *Hashed[T]) SymmetricDifference(other *Hashed[T]) *Hashed[T] {
+	result := s.Difference(other)
+	result.InsertSet(other.Difference(s))
+	return result
+}
+
+// IsSubsetOf - returns true if all keys from the current set are present in the other set, false otherwise
+func (s *Hashed[T]) IsSubsetOf(other *Hashed[T]) bool {
+	return other.HasSet(s)
+}
+
+// IsStrictSubsetOf - returns true if the current set is a subset of the other set,
+// and the other set contains at least one key which is not present in the current set, false otherwise
+func (s *Hashed[T]) IsStrictSubsetOf(other *Hashed[T]) bool {
+	return s.size < other.size && other.HasSet(s)
+}
+
+// IsSupersetOf - returns true if all keys from the other set are present in the current set, false otherwise
+func (s *Hashed[T]) IsSupersetOf(other *Hashed[T]) bool {
+	return s.HasSet(other)
+}
+
+// IsDisjoint - returns true if the current and given sets have no keys in common, false otherwise
+func (s *Hashed[T]) IsDisjoint(other *Hashed[T]) bool {
+	s1, s2 := s, other
+	if s2.size < s1.size {
+		s1, s2 = s2, s1
+	}
+
+	for key := range s1.All() {
+		if s2.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Copy - returns a copy of the current set
+func (s *Hashed[T]) Copy() *Hashed[T] {
+	other := NewHashed(s.hasher, len(s.buckets))
+	for hash, bucket := range s.buckets {
+		other.buckets[hash] = slices.Clone(bucket)
+	}
+	other.size = s.size
+	return other
+}
+
+// Slice - returns a copy of the current set as a slice
+func (s *Hashed[T]) Slice() []T {
+	return slices.AppendSeq(make([]T, 0, s.size), s.All())
+}
+
+// All - returns an iterator over the keys of the set
+func (s *Hashed[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, bucket := range s.buckets {
+			for _, key := range bucket {
+				if !yield(key) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Equal - returns true if the sets have the same size and contain the same keys, false otherwise
+func (s *Hashed[T]) Equal(other *Hashed[T]) bool {
+	if s.size != other.size {
+		return false
+	}
+
+	return s.HasSet(other)
+}
+
+// EqualSlice - returns true if the current set and given keys contain exactly the same keys.
+func (s *Hashed[T]) EqualSlice(items []T) bool {
+	if len(items) != s.Size() {
+		return false
+	}
+	return s.HasSlice(items)
+}
+
+// ForEach - iterates over the set, calling the given function `f` for each key
+func (s *Hashed[T]) ForEach(f func(T)) {
+	for key := range s.All() {
+		f(key)
+	}
+}
+
+// Fingerprint - returns an order-independent hash of the keys of the set, using the hasher of the set,
+// so that equal sets have equal fingerprints
+func (s *Hashed[T]) Fingerprint() uint64 {
+	return s.FingerprintFunc(s.hasher.Hash)
+}
+
+// FingerprintFunc - returns an order-independent hash of the keys of the set, using the given hash
+// function for the keys
+//
+//	fingerprint = Σ mix( hash(key) ) + size
+func (s *Hashed[T]) FingerprintFunc(hash func(T) uint64) uint64 {
+	fingerprint := uint64(s.size)
+
+	for key := range s.All() {
+		fingerprint += mathutil.Mix(hash(key))
+	}
+
+	return fingerprint
+}
+
+// indexOf - returns the index of the key in the bucket of the given hash, -1 if the key is not present
+func (s *Hashed[T]) indexOf(hash uint64, key T) int {
+	return slices.IndexFunc(s.buckets[hash], func(existing T) bool {
+		return s.hasher.Equal(existing, key)
+	})
+}
@@ -0,0 +1,151 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"hash/maphash"
+	"slices"
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/chain"
+	"github.com/stretchr/testify/assert"
+)
+
+type route struct {
+	name  string
+	hops  []int
+	extra []string
+}
+
+// constantHasher - hashes all the keys into the same bucket, to exercise the collisions
+type constantHasher struct{}
+
+func (constantHasher) Hash(int) uint64     { return 7 }
+func (constantHasher) Equal(a, b int) bool { return a == b }
+
+func TestHashed_Slices(t *testing.T) {
+	set := NewHashed(NewSliceHasher[int](), 0)
+
+	assert.True(t, set.Insert([]int{1, 2, 3}))
+	assert.False(t, set.Insert([]int{1, 2, 3}))
+	assert.True(t, set.Insert([]int{1, 2}))
+	assert.True(t, set.Insert(nil))
+
+	assert.Equal(t, 3, set.Size())
+	assert.True(t, set.Has([]int{1, 2}))
+	assert.True(t, set.Has([]int{}))
+	assert.False(t, set.Has([]int{2, 1}))
+
+	assert.True(t, set.Remove([]int{1, 2}))
+	assert.False(t, set.Remove([]int{1, 2}))
+	assert.Equal(t, 2, set.Size())
+}
+
+func TestHashed_Chains(t *testing.T) {
+	set := NewHashed(NewChainHasher[string](), 0)
+
+	set.Insert(chain.New([]string{"a", "b"}))
+	assert.True(t, set.Has(chain.New([]string{"a", "b"})))
+	assert.False(t, set.Has(chain.New([]string{"a"})))
+	assert.False(t, set.Has(chain.New([]string{"a", "b", "c"})))
+}
+
+func TestHashed_Structs(t *testing.T) {
+	hasher := NewHasher(func(hash *maphash.Hash, r route) {
+		hash.WriteString(r.name)
+		for _, hop := range r.hops {
+			maphash.WriteComparable(hash, hop)
+		}
+	}, func(a, b route) bool {
+		return a.name == b.name && slices.Equal(a.hops, b.hops)
+	})
+
+	set := HashedFrom(hasher, []route{
+		{name: "a", hops: []int{1, 2}},
+		{name: "a", hops: []int{1, 2}, extra: []string{"ignored"}},
+		{name: "b", hops: []int{1, 2}},
+	})
+	assert.Equal(t, 2, set.Size())
+}
+
+func TestHashed_Collisions(t *testing.T) {
+	set := HashedFrom[int](constantHasher{}, []int{1, 2, 3, 4, 5})
+	assert.Len(t, set.buckets, 1)
+
+	assert.True(t, set.Remove(2))
+	assert.True(t, set.EqualSlice([]int{1, 3, 4, 5}))
+	assert.True(t, set.FilterFunc(func(key int) bool { return key > 3 }))
+	assert.True(t, set.EqualSlice([]int{4, 5}))
+}
+
+func TestHashed_Algebra(t *testing.T) {
+	hasher := NewSliceHasher[int]()
+	s1 := HashedFrom(hasher, [][]int{{1}, {2}, {3}, {4}})
+	s2 := HashedFrom(hasher, [][]int{{3}, {4}, {5}})
+
+	assert.True(t, s1.Union(s2).EqualSlice([][]int{{1}, {2}, {3}, {4}, {5}}))
+	assert.True(t, s1.Difference(s2).EqualSlice([][]int{{1}, {2}}))
+	assert.True(t, s1.Intersect(s2).EqualSlice([][]int{{3}, {4}}))
+	assert.True(t, s1.SymmetricDifference(s2).EqualSlice([][]int{{1}, {2}, {5}}))
+	assert.True(t, s1.Intersect(s2).IsSubsetOf(s1))
+	assert.True(t, s1.Intersect(s2).IsStrictSubsetOf(s1))
+	assert.False(t, s1.IsStrictSubsetOf(s1))
+	assert.False(t, s1.IsStrictSubsetOf(s2))
+	assert.False(t, s1.IsDisjoint(s2))
+	assert.True(t, s1.Equal(s1.Copy()))
+
+	assert.True(t, s1.RemoveSet(s2))
+	assert.True(t, s1.EqualSlice([][]int{{1}, {2}}))
+}
+
+func TestHashed_Fingerprint(t *testing.T) {
+	hasher := NewSliceHasher[int]()
+	s1 := HashedFrom(hasher, [][]int{{1, 2}, {3}})
+	s2 := HashedFrom(hasher, [][]int{{3}, {1, 2}})
+
+	assert.Equal(t, s1.Fingerprint(), s2.Fingerprint())
+	assert.NotEqual(t, s1.Fingerprint(), HashedFrom(hasher, [][]int{{2, 1}, {3}}).Fingerprint())
+}
+
+func TestHashed_Marshal(t *testing.T) {
+	hasher := NewSliceHasher[int]()
+	set := HashedFrom(hasher, [][]int{{1, 2}})
+
+	data, err := json.Marshal(set)
+	assert.NoError(t, err)
+	assert.Equal(t, "[[1,2]]", string(data))
+	assert.Equal(t, "{[1 2]}", set.String())
+
+	decoded := NewHashed(hasher, 0)
+	assert.NoError(t, json.Unmarshal([]byte("[[1,2],[3],[1,2]]"), decoded))
+	assert.True(t, decoded.EqualSlice([][]int{{1, 2}, {3}}))
+	assert.ErrorIs(t, decoded.UnmarshalJSONWith([]byte("[[3],[3]]"), RejectDuplicates), ErrDuplicateKey)
+	assert.True(t, decoded.EqualSlice([][]int{{1, 2}, {3}}))
+	assert.ErrorIs(t, json.Unmarshal([]byte("[[1]]"), &Hashed[[]int]{}), ErrNoHasher)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buffer).Encode(decoded))
+	copied := NewHashed(hasher, 0)
+	assert.NoError(t, gob.NewDecoder(&buffer).Decode(copied))
+	assert.True(t, copied.Equal(decoded))
+}
@@ -0,0 +1,94 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"hash/maphash"
+	"slices"
+
+	"github.com/andrei-cosmin/sandata/chain"
+)
+
+// NewHasher - creates a new Hasher from the given functions, where `write` feeds the relevant parts of
+// the key into a maphash.Hash (seeded once per hasher), and `equal` compares two keys
+//
+//	hasher := set.NewHasher(func(h *maphash.Hash, u User) {
+//		h.WriteString(u.Name)
+//		maphash.WriteComparable(h, u.Id)
+//	}, func(a, b User) bool {
+//		return a.Name == b.Name && a.Id == b.Id
+//	})
+func NewHasher[T any](write func(*maphash.Hash, T), equal func(a, b T) bool) Hasher[T] {
+	return &funcHasher[T]{
+		seed:  maphash.MakeSeed(),
+		write: write,
+		equal: equal,
+	}
+}
+
+// NewSliceHasher - creates a new Hasher for slices of comparable elements, where
+// two slices are equal if they have the same elements in the same order
+func NewSliceHasher[E comparable]() Hasher[[]E] {
+	return NewHasher(func(hash *maphash.Hash, keys []E) {
+		for _, key := range keys {
+			maphash.WriteComparable(hash, key)
+		}
+	}, slices.Equal[[]E])
+}
+
+// NewChainHasher - creates a new Hasher for chains (key-paths), where two chains are equal if they
+// have the same keys in the same order, starting from the given nodes
+func NewChainHasher[K comparable]() Hasher[*chain.Node[K]] {
+	return NewHasher(func(hash *maphash.Hash, node *chain.Node[K]) {
+		for ; node != nil; node = node.Next {
+			maphash.WriteComparable(hash, node.Data)
+		}
+	}, func(n1, n2 *chain.Node[K]) bool {
+		for ; n1 != nil && n2 != nil; n1, n2 = n1.Next, n2.Next {
+			if n1.Data != n2.Data {
+				return false
+			}
+		}
+		return n1 == nil && n2 == nil
+	})
+}
+
+// funcHasher - represents a Hasher built from functions
+//   - seed maphash.Seed - the seed of the hasher
+//   - write func(*maphash.Hash, T) - the function feeding a key into the hash
+//   - equal func(a, b T) bool - the function comparing two keys
+type funcHasher[T any] struct {
+	seed  maphash.Seed
+	write func(*maphash.Hash, T)
+	equal func(a, b T) bool
+}
+
+// Hash - returns the hash of the key
+func (h *funcHasher[T]) Hash(key T) uint64 {
+	var hash maphash.Hash
+	hash.SetSeed(h.seed)
+	h.write(&hash, key)
+	return hash.Sum64()
+}
+
+// Equal - returns true if the two keys are equal, false otherwise
+func (h *funcHasher[T]) Equal(a, b T) bool {
+	return h.equal(a, b)
+}
@@ -0,0 +1,362 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"iter"
+
+	"github.com/andrei-cosmin/sandata/chain"
+)
+
+// NewLinked - creates a new insertion-ordered Linked set with pre-allocated memory for the given size
+func NewLinked[T comparable](size int) *Linked[T] {
+	return &Linked[T]{
+		nodes: make(map[T]*chain.Node[T], size),
+	}
+}
+
+// LinkedFrom - creates a new Linked set containing each key in the given slice, in the order of their
+// first occurrence
+func LinkedFrom[T comparable](keys []T) *Linked[T] {
+	s := NewLinked[T](len(keys))
+	s.InsertSlice(keys)
+	return s
+}
+
+// Linked - represents a set structure which remembers the insertion order of its keys
+//   - nodes map[T]*chain.Node[T] - the chain node of each key of the set
+//   - head *chain.Node[T] - the first (oldest) key of the set
+//   - tail *chain.Node[T] - the last (newest) key of the set
+type Linked[T comparable] struct {
+	nodes map[T]*chain.Node[T]
+	head  *chain.Node[T]
+	tail  *chain.Node[T]
+}
+
+// Insert - inserts the key at the back of the set, and returns
+// true if the set was modified (key didn't exist before), false otherwise
+//
+// NOTE: Inserting an existing key does not change its position (see MoveToBack)
+func (s *Linked[T]) Insert(key T) bool {
+	if _, exists := s.nodes[key]; exists {
+		return false
+	}
+
+	node := &chain.Node[T]{Data: key}
+	s.nodes[key] = node
+	s.pushBack(node)
+	return true
+}
+
+// InsertSlice - inserts each key from the given slice into the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Linked[T]) InsertSlice(keys []T) bool {
+	modified := false
+
+	for _, item := range keys {
+		if s.Insert(item) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// InsertSet - inserts each element of the given set (in its order) into the current set, and returns
+// true if the current set was modified (at least once), false otherwise
+func (s *Linked[T]) InsertSet(other *Linked[T]) bool {
+	modified := false
+
+	for node := other.head; node != nil; node = node.Next {
+		if s.Insert(node.Data) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Remove - removes the key from the set, and returns
+// true if the set was modified (key existed before), false otherwise
+func (s *Linked[T]) Remove(key T) bool {
+	node, exists := s.nodes[key]
+	if !exists {
+		return false
+	}
+
+	delete(s.nodes, key)
+	s.unlink(node)
+	return true
+}
+
+// RemoveSlice - removes each key in keys from the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Linked[T]) RemoveSlice(keys []T) bool {
+	modified := false
+
+	for _, item := range keys {
+		if s.Remove(item) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Has - returns true if key exists in the set, false otherwise
+func (s *Linked[T]) Has(key T) bool {
+	_, exists := s.nodes[key]
+	return exists
+}
+
+// HasSlice - returns true if all keys are present in the set, false otherwise
+//
+// NOTE: This method will return true for an empty slice
+func (s *Linked[T]) HasSlice(keys []T) bool {
+	for _, key := range keys {
+		if !s.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FilterFunc - filters the set using the given filter function, and returns
+// true if the set was modified, false otherwise
+func (s *Linked[T]) FilterFunc(filter func(T) bool) bool {
+	modified := false
+
+	for node := s.head; node != nil; {
+		next := node.Next
+		if !filter(node.Data) && s.Remove(node.Data) {
+			modified = true
+		}
+		node = next
+	}
+
+	return modified
+}
+
+// Size - returns the cardinality of the set
+func (s *Linked[T]) Size() int {
+	return len(s.nodes)
+}
+
+// Empty - returns true if the set contains no elements, false otherwise
+func (s *Linked[T]) Empty() bool {
+	return s.Size() == 0
+}
+
+// First - returns the first (oldest) key of the set, and false if the set is empty
+func (s *Linked[T]) First() (T, bool) {
+	return dataOf(s.head)
+}
+
+// Last - returns the last (newest) key of the set, and false if the set is empty
+func (s *Linked[T]) Last() (T, bool) {
+	return dataOf(s.tail)
+}
+
+// PopFirst - removes and returns the first (oldest) key of the set, and false if the set is empty
+func (s *Linked[T]) PopFirst() (T, bool) {
+	key, ok := dataOf(s.head)
+	if ok {
+		s.Remove(key)
+	}
+	return key, ok
+}
+
+// PopLast - removes and returns the last (newest) key of the set, and false if the set is empty
+func (s *Linked[T]) PopLast() (T, bool) {
+	key, ok := dataOf(s.tail)
+	if ok {
+		s.Remove(key)
+	}
+	return key, ok
+}
+
+// MoveToBack - moves the key to the back of the set (marking it as the most recent), and returns
+// true if the key exists in the set, false otherwise
+func (s *Linked[T]) MoveToBack(key T) bool {
+	node, exists := s.nodes[key]
+	if !exists {
+		return false
+	}
+
+	if node != s.tail {
+		s.unlink(node)
+		s.pushBack(node)
+	}
+
+	return true
+}
+
+// All - returns an iterator over the keys of the set in insertion order
+func (s *Linked[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := s.head; node != nil; node = node.Next {
+			if !yield(node.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Backward - returns an iterator over the keys of the set in reverse insertion order
+func (s *Linked[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := s.tail; node != nil; node = node.Prev {
+			if !yield(node.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Union - returns new set representing the union of the current and given sets
+// (keys of the current set first, followed by the new keys of the given set)
+//
+//	result = set.union(other) =>  result <- set ∪ other
+func (s *Linked[T]) Union(other *Linked[T]) *Linked[T] {
+	result := NewLinked[T](max(s.Size(), other.Size()))
+
+	result.InsertSet(s)
+	result.InsertSet(other)
+
+	return result
+}
+
+// Difference - returns a set representing the difference between the current and given sets,
+// preserving the order of the current set
+//
+//	result = set.difference(other) =>  result <- set \ other
+func (s *Linked[T]) Difference(other *Linked[T]) *Linked[T] {
+	result := NewLinked[T](max(0, s.Size()-other.Size()))
+
+	for node := s.head; node != nil; node = node.Next {
+		if !other.Has(node.Data) {
+			result.Insert(node.Data)
+		}
+	}
+
+	return result
+}
+
+// Intersect - returns a set representing the intersection of the current and given set,
+// preserving the order of the current set
+//
+//	result = set.intersect(other) =>  result <- set ∩ other
+func (s *Linked[T]) Intersect(other *Linked[T]) *Linked[T] {
+	result := NewLinked[T](0)
+
+	for node := s.head; node != nil; node = node.Next {
+		if other.Has(node.Data) {
+			result.Insert(node.Data)
+		}
+	}
+
+	return result
+}
+
+// Copy - returns a copy of the current set
+func (s *Linked[T]) Copy() *Linked[T] {
+	other := NewLinked[T](s.Size())
+	other.InsertSet(s)
+	return other
+}
+
+// Slice - returns a copy of the current set as a slice, in insertion order
+func (s *Linked[T]) Slice() []T {
+	otherSlice := make([]T, 0, s.Size())
+	for node := s.head; node != nil; node = node.Next {
+		otherSlice = append(otherSlice, node.Data)
+	}
+	return otherSlice
+}
+
+// Set - returns a copy of the current set as an unordered Set
+func (s *Linked[T]) Set() *Set[T] {
+	other := New[T](s.Size())
+	for key := range s.nodes {
+		other.keys[key] = empty
+	}
+	return other
+}
+
+// Equal - returns true if the sets contain the same keys (regardless of their order), false otherwise
+func (s *Linked[T]) Equal(other *Linked[T]) bool {
+	if s.Size() != other.Size() {
+		return false
+	}
+
+	for key := range other.nodes {
+		if !s.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualSlice - returns true if the current set and given keys contain exactly the same keys.
+func (s *Linked[T]) EqualSlice(items []T) bool {
+	if len(items) != s.Size() {
+		return false
+	}
+	return s.HasSlice(items)
+}
+
+// ForEach - iterates over the set in insertion order, calling the given function `f` for each key
+func (s *Linked[T]) ForEach(f func(T)) {
+	for node := s.head; node != nil; node = node.Next {
+		f(node.Data)
+	}
+}
+
+// pushBack - links the (isolated) node at the back of the set
+func (s *Linked[T]) pushBack(node *chain.Node[T]) {
+	if s.tail == nil {
+		s.head = node
+	} else {
+		node.InsertAsTail(s.tail)
+	}
+	s.tail = node
+}
+
+// unlink - unlinks the node from the set, and isolates it
+func (s *Linked[T]) unlink(node *chain.Node[T]) {
+	if s.head == node {
+		s.head = node.Next
+	}
+	if s.tail == node {
+		s.tail = node.Prev
+	}
+	node.RemoveNode()
+}
+
+// dataOf - returns the data of the given node, and false if the node is nil
+func dataOf[T comparable](node *chain.Node[T]) (T, bool) {
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+	return node.Data, true
+}
@@ -0,0 +1,93 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinked_Order(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+	set := LinkedFrom(append(values, values...))
+
+	assert.Equal(t, defaultSize, set.Size())
+	assert.Equal(t, values, set.Slice())
+	assert.Equal(t, values, slices.Collect(set.All()))
+
+	reversed := slices.Clone(values)
+	slices.Reverse(reversed)
+	assert.Equal(t, reversed, slices.Collect(set.Backward()))
+
+	assert.True(t, set.RemoveSlice(values[:defaultSize/2]))
+	assert.Equal(t, values[defaultSize/2:], set.Slice())
+}
+
+func TestLinked_FirstLast(t *testing.T) {
+	set := LinkedFrom([]int{1, 2, 3, 4})
+
+	first, ok := set.First()
+	assert.True(t, ok)
+	assert.Equal(t, 1, first)
+
+	assert.True(t, set.MoveToBack(1))
+	assert.True(t, set.MoveToBack(1))
+	assert.False(t, set.MoveToBack(5))
+	assert.Equal(t, []int{2, 3, 4, 1}, set.Slice())
+
+	last, ok := set.Last()
+	assert.True(t, ok)
+	assert.Equal(t, 1, last)
+
+	first, ok = set.PopFirst()
+	assert.True(t, ok)
+	assert.Equal(t, 2, first)
+
+	last, ok = set.PopLast()
+	assert.True(t, ok)
+	assert.Equal(t, 1, last)
+	assert.Equal(t, []int{3, 4}, set.Slice())
+
+	set.PopFirst()
+	set.PopFirst()
+	_, ok = set.PopFirst()
+	assert.False(t, ok)
+	assert.True(t, set.Empty())
+
+	set.Insert(7)
+	assert.Equal(t, []int{7}, slices.Collect(set.Backward()))
+}
+
+func TestLinked_Algebra(t *testing.T) {
+	s1 := LinkedFrom([]int{4, 3, 2, 1})
+	s2 := LinkedFrom([]int{5, 3, 4})
+
+	assert.Equal(t, []int{4, 3, 2, 1, 5}, s1.Union(s2).Slice())
+	assert.Equal(t, []int{2, 1}, s1.Difference(s2).Slice())
+	assert.Equal(t, []int{4, 3}, s1.Intersect(s2).Slice())
+	assert.True(t, s1.Equal(LinkedFrom([]int{1, 2, 3, 4})))
+	assert.True(t, s1.Set().EqualSlice([]int{1, 2, 3, 4}))
+
+	assert.True(t, s1.FilterFunc(func(key int) bool { return key%2 == 0 }))
+	assert.Equal(t, []int{4, 2}, s1.Copy().Slice())
+}
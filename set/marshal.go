@@ -0,0 +1,304 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unsafe"
+)
+
+// Duplicates - represents the policy applied to duplicate keys when decoding a set
+type Duplicates uint8
+
+const (
+	// DedupeDuplicates - duplicate keys are silently merged into a single key
+	DedupeDuplicates Duplicates = iota
+
+	// RejectDuplicates - duplicate keys cause decoding to fail with ErrDuplicateKey
+	RejectDuplicates
+)
+
+// ErrDuplicateKey - returned when decoding a set which contains the same key more than once,
+// and the RejectDuplicates policy is used
+var ErrDuplicateKey = errors.New("set: duplicate key")
+
+// Strict - represents a set which rejects duplicate keys when decoded from JSON, so the RejectDuplicates
+// policy also applies through json.Unmarshal (e.g. for struct fields)
+//   - *Set[T] - the decoded set (allocated when decoding, if nil)
+type Strict[T comparable] struct {
+	*Set[T]
+}
+
+// MarshalJSON - encodes the set as a JSON array (null if the set was not allocated)
+func (s Strict[T]) MarshalJSON() ([]byte, error) {
+	if s.Set == nil {
+		return []byte("null"), nil
+	}
+
+	return s.Set.MarshalJSON()
+}
+
+// UnmarshalJSON - decodes a JSON array into the set, replacing its contents, and returns
+// ErrDuplicateKey if a key is present more than once
+func (s *Strict[T]) UnmarshalJSON(data []byte) error {
+	if s.Set == nil {
+		s.Set = New[T](0)
+	}
+
+	return s.Set.UnmarshalJSONWith(data, RejectDuplicates)
+}
+
+// MarshalJSON - encodes the set as a JSON array
+//
+// NOTE: When T is an ordered type (integers, floats, strings), the keys are sorted so the output is stable
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.sortedSlice())
+}
+
+// UnmarshalJSON - decodes a JSON array into the set, replacing its contents
+//
+// NOTE: Duplicate keys are deduplicated (see UnmarshalJSONWith and Strict)
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	return s.UnmarshalJSONWith(data, DedupeDuplicates)
+}
+
+// UnmarshalJSONWith - decodes a JSON array into the set, replacing its contents,
+// and handles duplicate keys according to the given policy
+func (s *Set[T]) UnmarshalJSONWith(data []byte, duplicates Duplicates) error {
+	var keys []T
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+
+	return s.decodeKeys(keys, duplicates)
+}
+
+// GobEncode - encodes the set as a gob stream of its keys
+func (s *Set[T]) GobEncode() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(s.sortedSlice()); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// GobDecode - decodes a gob stream of keys into the set, replacing its contents
+//
+// NOTE: Duplicate keys are deduplicated
+func (s *Set[T]) GobDecode(data []byte) error {
+	var keys []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&keys); err != nil {
+		return err
+	}
+
+	return s.decodeKeys(keys, DedupeDuplicates)
+}
+
+// String - returns a string representation of the set
+//
+// NOTE: When T is an ordered type (integers, floats, strings), the keys are sorted
+//
+//	{1, 2, 3}
+func (s *Set[T]) String() string {
+	var builder strings.Builder
+
+	builder.WriteByte('{')
+	for index, key := range s.sortedSlice() {
+		if index > 0 {
+			builder.WriteString(", ")
+		}
+		_, _ = fmt.Fprint(&builder, key)
+	}
+	builder.WriteByte('}')
+
+	return builder.String()
+}
+
+// MarshalJSON - encodes the set as a JSON array
+//
+// NOTE: The keys are not sorted, since T is not ordered
+func (s *Hashed[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Slice())
+}
+
+// UnmarshalJSON - decodes a JSON array into the set, replacing its contents
+//
+// NOTE: Duplicate keys are deduplicated (see UnmarshalJSONWith), and the set must have a Hasher
+// (see NewHashed), otherwise ErrNoHasher is returned
+func (s *Hashed[T]) UnmarshalJSON(data []byte) error {
+	return s.UnmarshalJSONWith(data, DedupeDuplicates)
+}
+
+// UnmarshalJSONWith - decodes a JSON array into the set, replacing its contents,
+// and handles duplicate keys according to the given policy
+func (s *Hashed[T]) UnmarshalJSONWith(data []byte, duplicates Duplicates) error {
+	var keys []T
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+
+	return s.decodeKeys(keys, duplicates)
+}
+
+// GobEncode - encodes the set as a gob stream of its keys
+func (s *Hashed[T]) GobEncode() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(s.Slice()); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// GobDecode - decodes a gob stream of keys into the set, replacing its contents
+//
+// NOTE: Duplicate keys are deduplicated, and the set must have a Hasher (see NewHashed)
+func (s *Hashed[T]) GobDecode(data []byte) error {
+	var keys []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&keys); err != nil {
+		return err
+	}
+
+	return s.decodeKeys(keys, DedupeDuplicates)
+}
+
+// String - returns a string representation of the set
+//
+// NOTE: The keys are not sorted, since T is not ordered
+//
+//	{[1 2], [3]}
+func (s *Hashed[T]) String() string {
+	var builder strings.Builder
+
+	builder.WriteByte('{')
+	for index, key := range s.Slice() {
+		if index > 0 {
+			builder.WriteString(", ")
+		}
+		_, _ = fmt.Fprint(&builder, key)
+	}
+	builder.WriteByte('}')
+
+	return builder.String()
+}
+
+// decodeKeys - replaces the contents of the set with the given keys, applying the duplicates policy
+func (s *Hashed[T]) decodeKeys(keys []T, duplicates Duplicates) error {
+	if s.hasher == nil {
+		return ErrNoHasher
+	}
+
+	decoded := NewHashed(s.hasher, len(keys))
+	for _, key := range keys {
+		if !decoded.Insert(key) && duplicates == RejectDuplicates {
+			return fmt.Errorf("%w: %v", ErrDuplicateKey, key)
+		}
+	}
+
+	*s = *decoded
+	return nil
+}
+
+// decodeKeys - replaces the contents of the set with the given keys, applying the duplicates policy
+func (s *Set[T]) decodeKeys(keys []T, duplicates Duplicates) error {
+	decoded := make(map[T]nothing, len(keys))
+
+	for _, key := range keys {
+		if _, exists := decoded[key]; exists && duplicates == RejectDuplicates {
+			return fmt.Errorf("%w: %v", ErrDuplicateKey, key)
+		}
+		decoded[key] = empty
+	}
+
+	s.keys = decoded
+	return nil
+}
+
+// sortedSlice - returns the keys of the set as a slice, sorted if T is an ordered type
+func (s *Set[T]) sortedSlice() []T {
+	keys := s.Slice()
+
+	if compare := orderedCompare[T](); compare != nil {
+		slices.SortFunc(keys, compare)
+	}
+
+	return keys
+}
+
+// orderedCompare - returns a comparison function for T if its underlying type is ordered, nil otherwise
+//
+// NOTE: The comparison is picked once, by kind, so sorting does not go through reflection for each comparison
+func orderedCompare[T comparable]() func(T, T) int {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int:
+		return compareAs[T, int]()
+	case reflect.Int8:
+		return compareAs[T, int8]()
+	case reflect.Int16:
+		return compareAs[T, int16]()
+	case reflect.Int32:
+		return compareAs[T, int32]()
+	case reflect.Int64:
+		return compareAs[T, int64]()
+	case reflect.Uint:
+		return compareAs[T, uint]()
+	case reflect.Uint8:
+		return compareAs[T, uint8]()
+	case reflect.Uint16:
+		return compareAs[T, uint16]()
+	case reflect.Uint32:
+		return compareAs[T, uint32]()
+	case reflect.Uint64:
+		return compareAs[T, uint64]()
+	case reflect.Uintptr:
+		return compareAs[T, uintptr]()
+	case reflect.Float32:
+		return compareAs[T, float32]()
+	case reflect.Float64:
+		return compareAs[T, float64]()
+	case reflect.String:
+		return compareAs[T, string]()
+	default:
+		return nil
+	}
+}
+
+// compareAs - returns cmp.Compare for T, whose underlying type must be U
+//
+// NOTE: When T is U, cmp.Compare[U] is returned as is, otherwise (for named types) the keys are
+// reinterpreted as U, which shares their memory layout
+func compareAs[T comparable, U cmp.Ordered]() func(T, T) int {
+	if compare, ok := any(cmp.Compare[U]).(func(T, T) int); ok {
+		return compare
+	}
+
+	return func(a, b T) int {
+		return cmp.Compare(*(*U)(unsafe.Pointer(&a)), *(*U)(unsafe.Pointer(&b)))
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type permissions struct {
+	Name  string
+	Roles *Set[string]
+}
+
+type strictPermissions struct {
+	Name  string
+	Roles Strict[string]
+}
+
+type level int8
+
+func TestSet_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(From([]int{3, 1, 2}))
+	assert.NoError(t, err)
+	assert.Equal(t, "[1,2,3]", string(data))
+
+	data, err = json.Marshal(permissions{Name: "admin", Roles: From([]string{"write", "read"})})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Name":"admin","Roles":["read","write"]}`, string(data))
+}
+
+func TestSet_UnmarshalJSON(t *testing.T) {
+	var decoded permissions
+	assert.NoError(t, json.Unmarshal([]byte(`{"Name":"admin","Roles":["read","write","read"]}`), &decoded))
+	assert.True(t, decoded.Roles.EqualSlice([]string{"read", "write"}))
+
+	set := From([]int{10})
+	assert.NoError(t, set.UnmarshalJSON([]byte("[1,2]")))
+	assert.True(t, set.EqualSlice([]int{1, 2}))
+
+	err := set.UnmarshalJSONWith([]byte("[1,2,1]"), RejectDuplicates)
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+	assert.True(t, set.EqualSlice([]int{1, 2}))
+
+	assert.Error(t, set.UnmarshalJSON([]byte(`{"a":1}`)))
+}
+
+func TestStrict_UnmarshalJSON(t *testing.T) {
+	var decoded strictPermissions
+	err := json.Unmarshal([]byte(`{"Name":"admin","Roles":["read","write","read"]}`), &decoded)
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+
+	decoded = strictPermissions{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"Name":"admin","Roles":["write","read"]}`), &decoded))
+	assert.True(t, decoded.Roles.EqualSlice([]string{"read", "write"}))
+
+	data, err := json.Marshal(decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Name":"admin","Roles":["read","write"]}`, string(data))
+
+	data, err = json.Marshal(strictPermissions{Name: "guest"})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Name":"guest","Roles":null}`, string(data))
+}
+
+func TestSet_Gob(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buffer).Encode(From(values)))
+
+	decoded := New[uint](0)
+	assert.NoError(t, gob.NewDecoder(&buffer).Decode(decoded))
+	assert.True(t, decoded.EqualSlice(values))
+}
+
+func TestSet_String(t *testing.T) {
+	assert.Equal(t, "{}", New[int](0).String())
+	assert.Equal(t, "{-1, 2, 10}", From([]int{10, -1, 2}).String())
+	assert.Equal(t, "{a, b}", From([]string{"b", "a"}).String())
+	assert.Equal(t, "{-3, 1, 7}", From([]level{7, -3, 1}).String())
+	assert.Equal(t, "{0.5, 1.5, 2}", From([]float64{2, 0.5, 1.5}).String())
+}
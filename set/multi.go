@@ -0,0 +1,262 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// NewMulti - creates a new Multi set with pre-allocated memory for the given number of distinct keys
+func NewMulti[T comparable](size int) *Multi[T] {
+	return &Multi[T]{
+		counts: make(map[T]int, size),
+	}
+}
+
+// MultiFrom - creates a new Multi set counting the occurrences of each key in the given slice
+func MultiFrom[T comparable](keys []T) *Multi[T] {
+	m := NewMulti[T](len(keys))
+	for _, key := range keys {
+		m.Add(key, 1)
+	}
+	return m
+}
+
+// MultiFromSet - creates a new Multi set containing each key of the given set exactly once
+func MultiFromSet[T comparable](set *Set[T]) *Multi[T] {
+	m := NewMulti[T](set.Size())
+	for key := range set.keys {
+		m.counts[key] = 1
+	}
+	m.size = set.Size()
+	return m
+}
+
+// Counted - represents a key of a Multi set along with its number of occurrences
+//   - Key T - the key
+//   - Count int - the number of occurrences of the key
+type Counted[T comparable] struct {
+	Key   T
+	Count int
+}
+
+// Multi - represents a multiset (bag) structure, which counts the occurrences of its keys
+//   - counts map[T]int - the number of occurrences of each key (always positive)
+//   - size int - the total number of occurrences
+type Multi[T comparable] struct {
+	counts map[T]int
+	size   int
+}
+
+// Add - adds n occurrences of the key to the set, and returns the new count of the key
+//
+// NOTE: Non-positive values of n leave the set unchanged
+func (m *Multi[T]) Add(key T, n int) int {
+	if n <= 0 {
+		return m.counts[key]
+	}
+
+	m.counts[key] += n
+	m.size += n
+	return m.counts[key]
+}
+
+// Remove - removes (at most) n occurrences of the key from the set, and returns the new count of the key
+//
+// NOTE: Non-positive values of n leave the set unchanged
+func (m *Multi[T]) Remove(key T, n int) int {
+	count, exists := m.counts[key]
+	if !exists || n <= 0 {
+		return count
+	}
+
+	m.set(key, max(0, count-n))
+	return m.counts[key]
+}
+
+// RemoveAll - removes all the occurrences of the key from the set, and returns the number of removed occurrences
+func (m *Multi[T]) RemoveAll(key T) int {
+	count := m.counts[key]
+	m.set(key, 0)
+	return count
+}
+
+// Count - returns the number of occurrences of the key in the set
+func (m *Multi[T]) Count(key T) int {
+	return m.counts[key]
+}
+
+// Has - returns true if the key occurs at least once in the set, false otherwise
+func (m *Multi[T]) Has(key T) bool {
+	_, exists := m.counts[key]
+	return exists
+}
+
+// Size - returns the total number of occurrences in the set
+func (m *Multi[T]) Size() int {
+	return m.size
+}
+
+// Empty - returns true if the set contains no elements, false otherwise
+func (m *Multi[T]) Empty() bool {
+	return m.size == 0
+}
+
+// Distinct - returns the distinct keys of the set as a plain Set
+func (m *Multi[T]) Distinct() *Set[T] {
+	result := New[T](len(m.counts))
+	for key := range m.counts {
+		result.keys[key] = empty
+	}
+	return result
+}
+
+// Union - returns a multiset where the count of each key is the maximum of its counts in the two sets
+//
+//	result = set.union(other) =>  count(result, x) <- max( count(set, x), count(other, x) )
+func (m *Multi[T]) Union(other *Multi[T]) *Multi[T] {
+	result := m.Copy()
+	for key, count := range other.counts {
+		result.set(key, max(count, result.counts[key]))
+	}
+	return result
+}
+
+// Sum - returns a multiset where the count of each key is the sum of its counts in the two sets
+//
+//	result = set.sum(other) =>  count(result, x) <- count(set, x) + count(other, x)
+func (m *Multi[T]) Sum(other *Multi[T]) *Multi[T] {
+	result := m.Copy()
+	for key, count := range other.counts {
+		result.Add(key, count)
+	}
+	return result
+}
+
+// Intersect - returns a multiset where the count of each key is the minimum of its counts in the two sets
+//
+//	result = set.intersect(other) =>  count(result, x) <- min( count(set, x), count(other, x) )
+func (m *Multi[T]) Intersect(other *Multi[T]) *Multi[T] {
+	result := NewMulti[T](0)
+	m1, m2 := m, other
+	if len(m2.counts) < len(m1.counts) {
+		m1, m2 = m2, m1
+	}
+
+	for key, count := range m1.counts {
+		result.set(key, min(count, m2.counts[key]))
+	}
+	return result
+}
+
+// Difference - returns a multiset where the count of each key is its count in the current set, decreased
+// by its count in the given set (and dropped when no occurrences are left)
+//
+//	result = set.difference(other) =>  count(result, x) <- max( 0, count(set, x) - count(other, x) )
+func (m *Multi[T]) Difference(other *Multi[T]) *Multi[T] {
+	result := NewMulti[T](len(m.counts))
+	for key, count := range m.counts {
+		result.set(key, max(0, count-other.counts[key]))
+	}
+	return result
+}
+
+// MostCommon - returns the k keys with the most occurrences, ordered by descending count
+//
+// NOTE: All the keys are returned when k is negative or greater than the number of distinct keys,
+// and the order of keys with equal counts is unspecified
+func (m *Multi[T]) MostCommon(k int) []Counted[T] {
+	result := slices.Collect(m.entries())
+	slices.SortFunc(result, func(c1, c2 Counted[T]) int {
+		return cmp.Compare(c2.Count, c1.Count)
+	})
+
+	if k >= 0 && k < len(result) {
+		result = result[:k]
+	}
+	return result
+}
+
+// All - returns an iterator over the distinct keys of the set and their counts
+func (m *Multi[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for key, count := range m.counts {
+			if !yield(key, count) {
+				return
+			}
+		}
+	}
+}
+
+// Copy - returns a copy of the current set
+func (m *Multi[T]) Copy() *Multi[T] {
+	other := NewMulti[T](len(m.counts))
+	for key, count := range m.counts {
+		other.counts[key] = count
+	}
+	other.size = m.size
+	return other
+}
+
+// Equal - returns true if the sets contain the same keys with the same counts, false otherwise
+func (m *Multi[T]) Equal(other *Multi[T]) bool {
+	if m.size != other.size || len(m.counts) != len(other.counts) {
+		return false
+	}
+
+	for key, count := range other.counts {
+		if m.counts[key] != count {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ForEach - iterates over the set, calling the given function `f` for each distinct key and its count
+func (m *Multi[T]) ForEach(f func(T, int)) {
+	for key, count := range m.counts {
+		f(key, count)
+	}
+}
+
+// set - sets the count of the key, removing the key when the count is zero
+func (m *Multi[T]) set(key T, count int) {
+	m.size += count - m.counts[key]
+
+	if count == 0 {
+		delete(m.counts, key)
+	} else {
+		m.counts[key] = count
+	}
+}
+
+// entries - returns an iterator over the distinct keys of the set and their counts, as Counted values
+func (m *Multi[T]) entries() iter.Seq[Counted[T]] {
+	return func(yield func(Counted[T]) bool) {
+		for key, count := range m.counts {
+			if !yield(Counted[T]{Key: key, Count: count}) {
+				return
+			}
+		}
+	}
+}
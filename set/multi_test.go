@@ -0,0 +1,80 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMulti_Counts(t *testing.T) {
+	multi := MultiFrom([]string{"a", "b", "a", "c", "a", "b"})
+
+	assert.Equal(t, 6, multi.Size())
+	assert.Equal(t, 3, multi.Count("a"))
+	assert.Equal(t, 2, multi.Count("b"))
+	assert.Equal(t, 0, multi.Count("d"))
+
+	assert.Equal(t, 5, multi.Add("a", 2))
+	assert.Equal(t, 5, multi.Add("a", 0))
+	assert.Equal(t, 1, multi.Remove("a", 4))
+	assert.Equal(t, 0, multi.Remove("b", 10))
+	assert.False(t, multi.Has("b"))
+	assert.Equal(t, 2, multi.Size())
+
+	assert.Equal(t, 1, multi.RemoveAll("c"))
+	assert.Equal(t, 1, multi.Size())
+	assert.True(t, multi.Distinct().EqualSlice([]string{"a"}))
+}
+
+func TestMulti_Algebra(t *testing.T) {
+	m1 := MultiFrom([]int{1, 1, 1, 2, 3})
+	m2 := MultiFrom([]int{1, 2, 2, 4})
+
+	expect := func(expected map[int]int, actual *Multi[int]) {
+		result := NewMulti[int](len(expected))
+		for key, count := range expected {
+			result.Add(key, count)
+		}
+		assert.True(t, result.Equal(actual), actual.counts)
+	}
+
+	expect(map[int]int{1: 3, 2: 2, 3: 1, 4: 1}, m1.Union(m2))
+	expect(map[int]int{1: 4, 2: 3, 3: 1, 4: 1}, m1.Sum(m2))
+	expect(map[int]int{1: 1, 2: 1}, m1.Intersect(m2))
+	expect(map[int]int{1: 2, 3: 1}, m1.Difference(m2))
+	expect(map[int]int{2: 1, 4: 1}, m2.Difference(m1))
+}
+
+func TestMulti_MostCommon(t *testing.T) {
+	multi := MultiFrom([]int{5, 1, 1, 2, 2, 2, 3, 3, 3, 3})
+
+	assert.Equal(t, []Counted[int]{{Key: 3, Count: 4}, {Key: 2, Count: 3}}, multi.MostCommon(2))
+	assert.Len(t, multi.MostCommon(-1), 4)
+	assert.Empty(t, multi.MostCommon(0))
+}
+
+func TestMulti_Set(t *testing.T) {
+	multi := MultiFromSet(From([]int{1, 2, 3}))
+	assert.Equal(t, 3, multi.Size())
+	assert.Equal(t, 1, multi.Count(2))
+	assert.True(t, multi.Distinct().EqualSlice([]int{1, 2, 3}))
+}
@@ -0,0 +1,131 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"maps"
+	"runtime"
+	"sync"
+)
+
+// ParallelThreshold - the number of keys scanned below which the parallel operations fall back to the
+// serial ones
+//
+// NOTE: The threshold depends on the number of cores, so tune it with BenchmarkParallel on the target machine
+// (the smallest size from which the Parallel runs stay ahead of the Serial runs); the default keeps the
+// workers for sets of tens of thousands of keys, where starting them and merging their chunks is amortized
+var ParallelThreshold = 1 << 15
+
+// ParallelUnion - returns new set representing the union of the given sets, scanning the smaller set
+// across GOMAXPROCS workers
+//
+//	result = ParallelUnion(s1, s2) =>  result <- s1 ∪ s2
+func ParallelUnion[T comparable](s1 *Set[T], s2 *Set[T]) *Set[T] {
+	smaller, larger := sortSets(s1, s2)
+	if smaller.Size() < ParallelThreshold {
+		return s1.Union(s2)
+	}
+
+	missing := parallelFilter(smaller.Slice(), func(key T) bool {
+		return !larger.Has(key)
+	})
+
+	result := &Set[T]{keys: maps.Clone(larger.keys)}
+	for _, keys := range missing {
+		for _, key := range keys {
+			result.keys[key] = empty
+		}
+	}
+
+	return result
+}
+
+// ParallelDifference - returns a set representing the difference between the given sets, scanning the
+// first set across GOMAXPROCS workers
+//
+//	result = ParallelDifference(s1, s2) =>  result <- s1 \ s2
+func ParallelDifference[T comparable](s1 *Set[T], s2 *Set[T]) *Set[T] {
+	if s1.Size() < ParallelThreshold {
+		return s1.Difference(s2)
+	}
+
+	return collect(parallelFilter(s1.Slice(), func(key T) bool {
+		return !s2.Has(key)
+	}))
+}
+
+// ParallelIntersect - returns a set representing the intersection of the given sets, scanning the
+// smaller set across GOMAXPROCS workers
+//
+//	result = ParallelIntersect(s1, s2) =>  result <- s1 ∩ s2
+func ParallelIntersect[T comparable](s1 *Set[T], s2 *Set[T]) *Set[T] {
+	smaller, larger := sortSets(s1, s2)
+	if smaller.Size() < ParallelThreshold {
+		return s1.Intersect(s2)
+	}
+
+	return collect(parallelFilter(smaller.Slice(), larger.Has))
+}
+
+// parallelFilter - splits the keys into one chunk per worker, and returns the keys accepted by the
+// given function, for each chunk
+//
+// NOTE: The function is called concurrently, and must only read shared state
+func parallelFilter[T comparable](keys []T, keep func(T) bool) [][]T {
+	workers := max(1, min(runtime.GOMAXPROCS(0), len(keys)))
+	chunk := (len(keys) + workers - 1) / workers
+	results := make([][]T, workers)
+
+	var group sync.WaitGroup
+	for worker := range workers {
+		group.Go(func() {
+			start := worker * chunk
+			end := min(start+chunk, len(keys))
+
+			result := make([]T, 0, end-start)
+			for _, key := range keys[start:end] {
+				if keep(key) {
+					result = append(result, key)
+				}
+			}
+			results[worker] = result
+		})
+	}
+	group.Wait()
+
+	return results
+}
+
+// collect - returns a new set containing the keys of all the given chunks
+func collect[T comparable](chunks [][]T) *Set[T] {
+	size := 0
+	for _, keys := range chunks {
+		size += len(keys)
+	}
+
+	result := New[T](size)
+	for _, keys := range chunks {
+		for _, key := range keys {
+			result.keys[key] = empty
+		}
+	}
+
+	return result
+}
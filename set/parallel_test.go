@@ -0,0 +1,82 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallel(t *testing.T) {
+	threshold := ParallelThreshold
+	defer func() { ParallelThreshold = threshold }()
+
+	values := testutil.RandomUInts(10*defaultSize, maxRandomValue)
+	s1 := From(values[:6*defaultSize])
+	s2 := From(values[4*defaultSize:])
+
+	for _, ParallelThreshold = range []int{0, 100 * defaultSize} {
+		assert.True(t, ParallelUnion(s1, s2).Equal(s1.Union(s2)))
+		assert.True(t, ParallelDifference(s1, s2).Equal(s1.Difference(s2)))
+		assert.True(t, ParallelDifference(s2, s1).Equal(s2.Difference(s1)))
+		assert.True(t, ParallelIntersect(s1, s2).Equal(s1.Intersect(s2)))
+		assert.True(t, ParallelIntersect(s1, New[uint](0)).Empty())
+	}
+}
+
+// BenchmarkParallel - compares the serial operations (Set.Union, Set.Difference, Set.Intersect) with the
+// parallel ones (forced, without the fallback) for growing sets (half overlapping), showing the crossover
+// used for ParallelThreshold
+func BenchmarkParallel(b *testing.B) {
+	threshold := ParallelThreshold
+	defer func() { ParallelThreshold = threshold }()
+	ParallelThreshold = 0
+
+	for _, size := range []int{1 << 6, 1 << 8, 1 << 10, 1 << 12, 1 << 14, 1 << 16, 1 << 18, 1 << 20} {
+		values := testutil.RandomUInts(3*size/2, uint(8*size))
+		s1, s2 := From(values[:size]), From(values[size/2:])
+
+		operations := []struct {
+			name     string
+			serial   func() *Set[uint]
+			parallel func() *Set[uint]
+		}{
+			{"Union", func() *Set[uint] { return s1.Union(s2) }, func() *Set[uint] { return ParallelUnion(s1, s2) }},
+			{"Difference", func() *Set[uint] { return s1.Difference(s2) }, func() *Set[uint] { return ParallelDifference(s1, s2) }},
+			{"Intersect", func() *Set[uint] { return s1.Intersect(s2) }, func() *Set[uint] { return ParallelIntersect(s1, s2) }},
+		}
+
+		for _, operation := range operations {
+			b.Run(fmt.Sprintf("%s/Serial/%d", operation.name, size), func(b *testing.B) {
+				for b.Loop() {
+					operation.serial()
+				}
+			})
+			b.Run(fmt.Sprintf("%s/Parallel/%d", operation.name, size), func(b *testing.B) {
+				for b.Loop() {
+					operation.parallel()
+				}
+			})
+		}
+	}
+}
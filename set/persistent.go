@@ -0,0 +1,601 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"hash/maphash"
+	"iter"
+	"math/bits"
+	"slices"
+)
+
+const (
+	// hamtBits - the number of hash bits consumed by each level of the trie
+	hamtBits = 5
+
+	// hamtMask - the mask extracting the hash bits of a level
+	hamtMask = 1<<hamtBits - 1
+
+	// hamtDepth - the shift from which the hash bits are exhausted, and keys with equal hashes are
+	// stored in collision nodes
+	hamtDepth = 64
+)
+
+// persistentSeed - the seed used for hashing the keys of all Persistent sets, so that versions and
+// different sets can be combined
+var persistentSeed = maphash.MakeSeed()
+
+// owner - represents the identity of a Transient set, marking the nodes it is allowed to modify in place
+type owner struct {
+	_ byte
+}
+
+// hamtEntry - represents an entry of a trie node, which is either a key (child == nil) or a child node
+//   - hash uint64 - the hash of the key
+//   - key T - the key
+//   - child *hamtNode[T] - the child node
+type hamtEntry[T comparable] struct {
+	hash  uint64
+	key   T
+	child *hamtNode[T]
+}
+
+// hamtNode - represents a node of a hash array mapped trie
+//   - bitmap uint32 - the hash fragments present in the node (unused by collision nodes)
+//   - entries []hamtEntry[T] - the entries of the node, in the order of their hash fragments
+//   - owner *owner - the Transient set allowed to modify the node in place (nil if none)
+type hamtNode[T comparable] struct {
+	bitmap  uint32
+	entries []hamtEntry[T]
+	owner   *owner
+}
+
+// NewPersistent - creates a new empty Persistent set
+func NewPersistent[T comparable]() *Persistent[T] {
+	return &Persistent[T]{}
+}
+
+// PersistentFrom - creates a new Persistent set containing each key in the given slice
+func PersistentFrom[T comparable](keys []T) *Persistent[T] {
+	t := NewPersistent[T]().Transient()
+	t.InsertSlice(keys)
+	return t.Persistent()
+}
+
+// Persistent - represents an immutable set structure, backed by a hash array mapped trie (HAMT)
+//   - root *hamtNode[T] - the root node of the trie (nil for an empty set)
+//   - size int - the cardinality of the set
+//
+// NOTE: Every modification returns a new version of the set, which shares the unchanged nodes with the
+// previous version, so snapshots are O(1) and modifications are O(log n)
+type Persistent[T comparable] struct {
+	root *hamtNode[T]
+	size int
+}
+
+// Insert - returns a version of the set containing the key
+//
+// NOTE: The current version is returned when the key already exists
+func (p *Persistent[T]) Insert(key T) *Persistent[T] {
+	root, added := hamtInsert(p.root, 0, entryOf(key), nil)
+	if !added {
+		return p
+	}
+
+	return &Persistent[T]{root: root, size: p.size + 1}
+}
+
+// InsertSlice - returns a version of the set containing each key from the given slice
+func (p *Persistent[T]) InsertSlice(keys []T) *Persistent[T] {
+	t := p.Transient()
+	t.InsertSlice(keys)
+	return t.Persistent()
+}
+
+// Remove - returns a version of the set without the key
+//
+// NOTE: The current version is returned when the key does not exist
+func (p *Persistent[T]) Remove(key T) *Persistent[T] {
+	root, removed := hamtRemove(p.root, 0, entryOf(key), nil)
+	if !removed {
+		return p
+	}
+
+	return &Persistent[T]{root: root, size: p.size - 1}
+}
+
+// RemoveSlice - returns a version of the set without each key from the given slice
+func (p *Persistent[T]) RemoveSlice(keys []T) *Persistent[T] {
+	t := p.Transient()
+	t.RemoveSlice(keys)
+	return t.Persistent()
+}
+
+// Has - returns true if key exists in the set, false otherwise
+func (p *Persistent[T]) Has(key T) bool {
+	return hamtHas(p.root, entryOf(key))
+}
+
+// HasSlice - returns true if all keys are present in the set, false otherwise
+//
+// NOTE: This method will return true for an empty slice
+func (p *Persistent[T]) HasSlice(keys []T) bool {
+	for _, key := range keys {
+		if !p.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasSet - returns true if all keys from the other set are present in the current set, false otherwise
+//
+// NOTE: This method will return true for an empty given set
+func (p *Persistent[T]) HasSet(other *Persistent[T]) bool {
+	if other.size > p.size {
+		return false
+	}
+
+	for entry := range other.entries() {
+		if !hamtHas(p.root, entry) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FilterFunc - returns a version of the set containing only the keys accepted by the given filter function
+func (p *Persistent[T]) FilterFunc(filter func(T) bool) *Persistent[T] {
+	t := p.Transient()
+
+	for entry := range p.entries() {
+		if !filter(entry.key) {
+			t.removeEntry(entry)
+		}
+	}
+
+	return t.Persistent()
+}
+
+// Size - returns the cardinality of the set
+func (p *Persistent[T]) Size() int {
+	return p.size
+}
+
+// Empty - returns true if the set contains no elements, false otherwise
+func (p *Persistent[T]) Empty() bool {
+	return p.size == 0
+}
+
+// Union - returns new set representing the union of the current and given sets
+//
+//	result = set.union(other) =>  result <- set ∪ other
+func (p *Persistent[T]) Union(other *Persistent[T]) *Persistent[T] {
+	larger, smaller := other, p
+	if p.size > other.size {
+		larger, smaller = p, other
+	}
+
+	t := larger.Transient()
+	for entry := range smaller.entries() {
+		t.insertEntry(entry)
+	}
+
+	return t.Persistent()
+}
+
+// Difference - returns a set representing the difference between the current and given sets
+//
+//	result = set.difference(other) =>  result <- set \ other
+func (p *Persistent[T]) Difference(other *Persistent[T]) *Persistent[T] {
+	if other.size < p.size {
+		t := p.Transient()
+		for entry := range other.entries() {
+			t.removeEntry(entry)
+		}
+		return t.Persistent()
+	}
+
+	t := NewPersistent[T]().Transient()
+	for entry := range p.entries() {
+		if !hamtHas(other.root, entry) {
+			t.insertEntry(entry)
+		}
+	}
+
+	return t.Persistent()
+}
+
+// Intersect - returns a set representing the intersection of the current and given set
+//
+//	result = set.intersect(other) =>  result <- set ∩ other
+func (p *Persistent[T]) Intersect(other *Persistent[T]) *Persistent[T] {
+	larger, smaller := other, p
+	if p.size > other.size {
+		larger, smaller = p, other
+	}
+
+	t := NewPersistent[T]().Transient()
+	for entry := range smaller.entries() {
+		if hamtHas(larger.root, entry) {
+			t.insertEntry(entry)
+		}
+	}
+
+	return t.Persistent()
+}
+
+// Transient - returns a mutable Transient set starting from the current version, for batched building
+//
+// NOTE: The current version is never modified by the returned Transient set
+func (p *Persistent[T]) Transient() *Transient[T] {
+	return &Transient[T]{
+		root:  p.root,
+		size:  p.size,
+		owner: &owner{},
+	}
+}
+
+// All - returns an iterator over the keys of the set
+func (p *Persistent[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for entry := range p.entries() {
+			if !yield(entry.key) {
+				return
+			}
+		}
+	}
+}
+
+// Slice - returns a copy of the current set as a slice
+func (p *Persistent[T]) Slice() []T {
+	return slices.AppendSeq(make([]T, 0, p.size), p.All())
+}
+
+// Set - returns a copy of the current set as a mutable Set
+func (p *Persistent[T]) Set() *Set[T] {
+	other := New[T](p.size)
+	for entry := range p.entries() {
+		other.keys[entry.key] = empty
+	}
+	return other
+}
+
+// Equal - returns true if the sets have the same size and contain the same keys, false otherwise
+func (p *Persistent[T]) Equal(other *Persistent[T]) bool {
+	if p.size != other.size {
+		return false
+	}
+
+	return p.root == other.root || p.HasSet(other)
+}
+
+// EqualSlice - returns true if the current set and given keys contain exactly the same keys.
+func (p *Persistent[T]) EqualSlice(items []T) bool {
+	if len(items) != p.size {
+		return false
+	}
+	return p.HasSlice(items)
+}
+
+// ForEach - iterates over the set, calling the given function `f` for each key
+func (p *Persistent[T]) ForEach(f func(T)) {
+	for entry := range p.entries() {
+		f(entry.key)
+	}
+}
+
+// entries - returns an iterator over the key entries of the set
+func (p *Persistent[T]) entries() iter.Seq[hamtEntry[T]] {
+	return func(yield func(hamtEntry[T]) bool) {
+		hamtWalk(p.root, yield)
+	}
+}
+
+// Transient - represents a mutable set structure used for building a Persistent set in batches, which
+// modifies in place the trie nodes it has already copied
+//   - root *hamtNode[T] - the root node of the trie (nil for an empty set)
+//   - size int - the cardinality of the set
+//   - owner *owner - the identity of the set, marking the nodes it owns
+type Transient[T comparable] struct {
+	root  *hamtNode[T]
+	size  int
+	owner *owner
+}
+
+// Insert - inserts the key into the set, and returns
+// true if the set was modified (key didn't exist before), false otherwise
+func (t *Transient[T]) Insert(key T) bool {
+	return t.insertEntry(entryOf(key))
+}
+
+// InsertSlice - inserts each key from the given slice into the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (t *Transient[T]) InsertSlice(keys []T) bool {
+	modified := false
+
+	for _, key := range keys {
+		if t.Insert(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Remove - removes the key from the set, and returns
+// true if the set was modified (key existed before), false otherwise
+func (t *Transient[T]) Remove(key T) bool {
+	return t.removeEntry(entryOf(key))
+}
+
+// RemoveSlice - removes each key in keys from the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (t *Transient[T]) RemoveSlice(keys []T) bool {
+	modified := false
+
+	for _, key := range keys {
+		if t.Remove(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Has - returns true if key exists in the set, false otherwise
+func (t *Transient[T]) Has(key T) bool {
+	return hamtHas(t.root, entryOf(key))
+}
+
+// Size - returns the cardinality of the set
+func (t *Transient[T]) Size() int {
+	return t.size
+}
+
+// Persistent - returns the current contents of the set as an immutable Persistent set
+//
+// NOTE: The transient set remains usable, further modifications do not affect the returned set
+func (t *Transient[T]) Persistent() *Persistent[T] {
+	// Give up the ownership of the nodes, which are now shared with the returned set
+	t.owner = &owner{}
+
+	return &Persistent[T]{root: t.root, size: t.size}
+}
+
+// insertEntry - inserts the key entry into the set, and returns true if the set was modified
+func (t *Transient[T]) insertEntry(entry hamtEntry[T]) bool {
+	root, added := hamtInsert(t.root, 0, entry, t.owner)
+	t.root = root
+	if added {
+		t.size++
+	}
+	return added
+}
+
+// removeEntry - removes the key entry from the set, and returns true if the set was modified
+func (t *Transient[T]) removeEntry(entry hamtEntry[T]) bool {
+	root, removed := hamtRemove(t.root, 0, entry, t.owner)
+	t.root = root
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+// entryOf - returns the key entry for the given key
+func entryOf[T comparable](key T) hamtEntry[T] {
+	return hamtEntry[T]{
+		hash: maphash.Comparable(persistentSeed, key),
+		key:  key,
+	}
+}
+
+// position - returns the bit of the hash fragment at the given shift, and the index of its entry in the node
+func (n *hamtNode[T]) position(hash uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+// editable - returns the node itself if it is owned by the given owner, or a copy owned by the given owner
+func (n *hamtNode[T]) editable(owner *owner) *hamtNode[T] {
+	if owner != nil && n.owner == owner {
+		return n
+	}
+
+	return &hamtNode[T]{
+		bitmap:  n.bitmap,
+		entries: slices.Clone(n.entries),
+		owner:   owner,
+	}
+}
+
+// hamtInsert - inserts the key entry into the subtree at the given shift, and returns the new subtree root
+// along with true if the key was added, false if it already existed (in which case the node is unchanged)
+func hamtInsert[T comparable](n *hamtNode[T], shift uint, entry hamtEntry[T], owner *owner) (*hamtNode[T], bool) {
+	if n == nil {
+		n = &hamtNode[T]{owner: owner}
+	}
+
+	// Collision node, keys with equal hashes are kept in a list
+	if shift >= hamtDepth {
+		for _, existing := range n.entries {
+			if existing.key == entry.key {
+				return n, false
+			}
+		}
+
+		e := n.editable(owner)
+		e.entries = append(e.entries, entry)
+		return e, true
+	}
+
+	bit, index := n.position(entry.hash, shift)
+
+	// Free slot, the key is stored directly in the node
+	if n.bitmap&bit == 0 {
+		e := n.editable(owner)
+		e.entries = slices.Insert(e.entries, index, entry)
+		e.bitmap |= bit
+		return e, true
+	}
+
+	existing := n.entries[index]
+
+	// Child node, the key is inserted one level below
+	if existing.child != nil {
+		child, added := hamtInsert(existing.child, shift+hamtBits, entry, owner)
+		if !added {
+			return n, false
+		}
+
+		e := n.editable(owner)
+		e.entries[index].child = child
+		return e, true
+	}
+
+	if existing.hash == entry.hash && existing.key == entry.key {
+		return n, false
+	}
+
+	// Two keys share the hash fragment, both are pushed into a new child node
+	child, _ := hamtInsert(nil, shift+hamtBits, existing, owner)
+	child, _ = hamtInsert(child, shift+hamtBits, entry, owner)
+
+	e := n.editable(owner)
+	e.entries[index] = hamtEntry[T]{child: child}
+	return e, true
+}
+
+// hamtRemove - removes the key entry from the subtree at the given shift, and returns the new subtree root
+// (nil if the subtree became empty) along with true if the key was removed, false if it didn't exist
+// (in which case the node is unchanged)
+func hamtRemove[T comparable](n *hamtNode[T], shift uint, entry hamtEntry[T], owner *owner) (*hamtNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	// Collision node, keys with equal hashes are kept in a list
+	if shift >= hamtDepth {
+		index := slices.IndexFunc(n.entries, func(existing hamtEntry[T]) bool {
+			return existing.key == entry.key
+		})
+		if index < 0 {
+			return n, false
+		}
+		if len(n.entries) == 1 {
+			return nil, true
+		}
+
+		e := n.editable(owner)
+		e.entries = slices.Delete(e.entries, index, index+1)
+		return e, true
+	}
+
+	bit, index := n.position(entry.hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	existing := n.entries[index]
+
+	// Child node, the key is removed one level below
+	if existing.child != nil {
+		child, removed := hamtRemove(existing.child, shift+hamtBits, entry, owner)
+		if !removed {
+			return n, false
+		}
+
+		e := n.editable(owner)
+		switch {
+		case child == nil:
+			e.entries = slices.Delete(e.entries, index, index+1)
+			e.bitmap &^= bit
+		case len(child.entries) == 1 && child.entries[0].child == nil:
+			// A child left with a single key is collapsed into its parent
+			e.entries[index] = child.entries[0]
+		default:
+			e.entries[index].child = child
+		}
+
+		if len(e.entries) == 0 {
+			return nil, true
+		}
+		return e, true
+	}
+
+	if existing.hash != entry.hash || existing.key != entry.key {
+		return n, false
+	}
+	if len(n.entries) == 1 {
+		return nil, true
+	}
+
+	e := n.editable(owner)
+	e.entries = slices.Delete(e.entries, index, index+1)
+	e.bitmap &^= bit
+	return e, true
+}
+
+// hamtHas - returns true if the key entry exists in the trie rooted at the given node, false otherwise
+func hamtHas[T comparable](n *hamtNode[T], entry hamtEntry[T]) bool {
+	for shift := uint(0); n != nil; shift += hamtBits {
+		if shift >= hamtDepth {
+			return slices.ContainsFunc(n.entries, func(existing hamtEntry[T]) bool {
+				return existing.key == entry.key
+			})
+		}
+
+		bit, index := n.position(entry.hash, shift)
+		if n.bitmap&bit == 0 {
+			return false
+		}
+
+		existing := n.entries[index]
+		if existing.child == nil {
+			return existing.hash == entry.hash && existing.key == entry.key
+		}
+		n = existing.child
+	}
+
+	return false
+}
+
+// hamtWalk - calls yield for each key entry of the trie rooted at the given node, and returns
+// false if the iteration was stopped
+func hamtWalk[T comparable](n *hamtNode[T], yield func(hamtEntry[T]) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	for _, entry := range n.entries {
+		if entry.child != nil {
+			if !hamtWalk(entry.child, yield) {
+				return false
+			}
+		} else if !yield(entry) {
+			return false
+		}
+	}
+
+	return true
+}
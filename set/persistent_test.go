@@ -0,0 +1,107 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistent_Versions(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+
+	versions := []*Persistent[uint]{NewPersistent[uint]()}
+	for _, value := range values {
+		versions = append(versions, versions[len(versions)-1].Insert(value))
+	}
+
+	for size, version := range versions {
+		assert.Equal(t, size, version.Size())
+		assert.True(t, version.EqualSlice(values[:size]))
+	}
+
+	last := versions[len(versions)-1]
+	assert.Same(t, last, last.Insert(values[0]))
+	assert.Same(t, last, last.Remove(maxRandomValue))
+
+	removed := last.RemoveSlice(values[:defaultSize/2])
+	assert.True(t, removed.EqualSlice(values[defaultSize/2:]))
+	assert.True(t, last.EqualSlice(values))
+
+	for _, value := range values[defaultSize/2:] {
+		removed = removed.Remove(value)
+	}
+	assert.True(t, removed.Empty())
+	assert.Nil(t, removed.root)
+}
+
+func TestPersistent_Transient(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+	base := PersistentFrom(values[:defaultSize/2])
+
+	transient := base.Transient()
+	assert.True(t, transient.InsertSlice(values[defaultSize/2:]))
+	assert.True(t, transient.Remove(values[0]))
+	assert.False(t, transient.Has(values[0]))
+
+	first := transient.Persistent()
+	assert.True(t, transient.Insert(values[0]))
+	second := transient.Persistent()
+
+	assert.True(t, base.EqualSlice(values[:defaultSize/2]))
+	assert.True(t, first.EqualSlice(values[1:]))
+	assert.True(t, second.EqualSlice(values))
+	assert.Equal(t, defaultSize, transient.Size())
+}
+
+func TestPersistent_Algebra(t *testing.T) {
+	s1 := PersistentFrom([]int{1, 2, 3, 4})
+	s2 := PersistentFrom([]int{3, 4, 5})
+
+	assert.True(t, s1.Union(s2).EqualSlice([]int{1, 2, 3, 4, 5}))
+	assert.True(t, s1.Difference(s2).EqualSlice([]int{1, 2}))
+	assert.True(t, s2.Difference(s1).EqualSlice([]int{5}))
+	assert.True(t, s1.Intersect(s2).EqualSlice([]int{3, 4}))
+	assert.True(t, s1.FilterFunc(func(key int) bool { return key%2 == 0 }).EqualSlice([]int{2, 4}))
+	assert.True(t, s1.Set().EqualSlice([]int{1, 2, 3, 4}))
+	assert.ElementsMatch(t, []int{1, 2, 3, 4}, s1.Slice())
+	assert.True(t, s1.Equal(s1.Union(s2).Difference(PersistentFrom([]int{5}))))
+}
+
+func TestPersistent_Collisions(t *testing.T) {
+	var root *hamtNode[int]
+	for key := range 10 {
+		root, _ = hamtInsert(root, 0, hamtEntry[int]{hash: 42, key: key}, nil)
+	}
+
+	for key := range 10 {
+		assert.True(t, hamtHas(root, hamtEntry[int]{hash: 42, key: key}))
+	}
+	assert.False(t, hamtHas(root, hamtEntry[int]{hash: 42, key: 10}))
+
+	for key := range 10 {
+		var removed bool
+		root, removed = hamtRemove(root, 0, hamtEntry[int]{hash: 42, key: key}, nil)
+		assert.True(t, removed)
+	}
+	assert.Nil(t, root)
+}
@@ -19,6 +19,8 @@
 
 package set
 
+import "slices"
+
 // nothing represents an empty zero-alloc struct
 type nothing struct{}
 
@@ -79,7 +81,6 @@ func (s *Set[T]) InsertSet(other *Set[T]) bool {
 		if s.Insert(key) {
 			modified = true
 		}
-		return true
 	}
 
 	return modified
@@ -118,7 +119,6 @@ func (s *Set[T]) RemoveSet(other *Set[T]) bool {
 		if s.Remove(key) {
 			modified = true
 		}
-		return true
 	}
 
 	return modified
@@ -134,30 +134,63 @@ func (s *Set[T]) Has(key T) bool {
 //
 // NOTE: This method will return true for an empty slice
 func (s *Set[T]) HasSlice(keys []T) bool {
-	hasSlice := true
-
 	for _, key := range keys {
 		if !s.Has(key) {
-			hasSlice = false
+			return false
 		}
 	}
 
-	return hasSlice
+	return true
 }
 
 // HasSet - returns true if all keys from the other set are present in the current set, false otherwise
 //
 // NOTE: This method will return true for an empty given set
 func (s *Set[T]) HasSet(other *Set[T]) bool {
-	hasSet := true
+	if other.Size() > s.Size() {
+		return false
+	}
 
 	for key := range other.keys {
 		if !s.Has(key) {
-			hasSet = false
+			return false
 		}
 	}
 
-	return hasSet
+	return true
+}
+
+// IsSubsetOf - returns true if all keys from the current set are present in the other set, false otherwise
+//
+// NOTE: An empty set is a subset of any set
+func (s *Set[T]) IsSubsetOf(other *Set[T]) bool {
+	return other.HasSet(s)
+}
+
+// IsStrictSubsetOf - returns true if the current set is a subset of the other set,
+// and the other set contains at least one key which is not present in the current set, false otherwise
+func (s *Set[T]) IsStrictSubsetOf(other *Set[T]) bool {
+	return s.Size() < other.Size() && other.HasSet(s)
+}
+
+// IsSupersetOf - returns true if all keys from the other set are present in the current set, false otherwise
+//
+// NOTE: Any set is a superset of an empty set
+func (s *Set[T]) IsSupersetOf(other *Set[T]) bool {
+	return s.HasSet(other)
+}
+
+// IsDisjoint - returns true if the current and given sets have no keys in common, false otherwise
+func (s *Set[T]) IsDisjoint(other *Set[T]) bool {
+	s1, s2 := sortSets(s, other)
+
+	for key := range s1.keys {
+		if s2.Has(key) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // FilterFunc - filters the set using the given filter function, and returns
@@ -227,6 +260,27 @@ func (s *Set[T]) Intersect(set *Set[T]) *Set[T] {
 	return result
 }
 
+// SymmetricDifference - returns a set representing the keys present in exactly one of the current and given sets
+//
+//	result = set.symmetricDifference(other) =>  result <- (set \ other) ∪ (other \ set)
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	result := New[T](s.Size() + other.Size())
+
+	for key := range s.keys {
+		if !other.Has(key) {
+			result.keys[key] = empty
+		}
+	}
+
+	for key := range other.keys {
+		if !s.Has(key) {
+			result.keys[key] = empty
+		}
+	}
+
+	return result
+}
+
 // Copy - returns a copy of the current set
 func (s *Set[T]) Copy() *Set[T] {
 	other := New[T](s.Size())
@@ -269,6 +323,63 @@ func (s *Set[T]) ForEach(f func(T)) {
 	}
 }
 
+// UnionAll - returns a new set representing the union of all the given sets
+//
+//	result = UnionAll(s1, s2, ..., sn) =>  result <- s1 ∪ s2 ∪ ... ∪ sn
+func UnionAll[T comparable](sets ...*Set[T]) *Set[T] {
+	size := 0
+	for _, set := range sets {
+		size = max(size, set.Size())
+	}
+
+	result := New[T](size)
+	for _, set := range sets {
+		for key := range set.keys {
+			result.keys[key] = empty
+		}
+	}
+
+	return result
+}
+
+// IntersectAll - returns a new set representing the intersection of all the given sets
+//
+//	result = IntersectAll(s1, s2, ..., sn) =>  result <- s1 ∩ s2 ∩ ... ∩ sn
+//
+// NOTE: The intersection of no sets is an empty set
+func IntersectAll[T comparable](sets ...*Set[T]) *Set[T] {
+	if len(sets) == 0 {
+		return New[T](0)
+	}
+
+	sorted := slices.Clone(sets)
+	slices.SortFunc(sorted, func(s1, s2 *Set[T]) int {
+		return s1.Size() - s2.Size()
+	})
+
+	smallest, others := sorted[0], sorted[1:]
+	result := New[T](smallest.Size())
+
+	for key := range smallest.keys {
+		if hasAll(others, key) {
+			result.keys[key] = empty
+		}
+	}
+
+	return result
+}
+
+// hasAll - returns true if the key is present in each of the given sets, false otherwise
+func hasAll[T comparable](sets []*Set[T], key T) bool {
+	for _, set := range sets {
+		if !set.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func sortSets[T comparable](s1 *Set[T], s2 *Set[T]) (*Set[T], *Set[T]) {
 	if s1.Size() < s2.Size() {
 		return s1, s2
@@ -48,3 +48,66 @@ func TestSet_FilterFunc(t *testing.T) {
 		assert.True(t, value%2 == 0)
 	})
 }
+
+func TestSet_InsertSet(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+	set := New[uint](0)
+
+	assert.True(t, set.InsertSet(From(values)))
+	assert.False(t, set.InsertSet(From(values)))
+	assert.True(t, set.EqualSlice(values))
+
+	assert.True(t, set.RemoveSet(From(values[:defaultSize/2])))
+	assert.True(t, set.EqualSlice(values[defaultSize/2:]))
+}
+
+func TestSet_HasSlice(t *testing.T) {
+	set := From([]int{1, 2, 3, 4})
+
+	assert.True(t, set.HasSlice(nil))
+	assert.True(t, set.HasSlice([]int{1, 3}))
+	assert.False(t, set.HasSlice([]int{1, 5}))
+	assert.True(t, set.HasSet(From([]int{2, 4})))
+	assert.False(t, set.HasSet(From([]int{1, 2, 3, 4, 5})))
+}
+
+func TestSet_SymmetricDifference(t *testing.T) {
+	s1 := From([]int{1, 2, 3, 4})
+	s2 := From([]int{3, 4, 5, 6})
+
+	assert.True(t, s1.SymmetricDifference(s2).EqualSlice([]int{1, 2, 5, 6}))
+	assert.True(t, s2.SymmetricDifference(s1).EqualSlice([]int{1, 2, 5, 6}))
+	assert.True(t, s1.SymmetricDifference(s1).Empty())
+}
+
+func TestSet_Relations(t *testing.T) {
+	s1 := From([]int{1, 2})
+	s2 := From([]int{1, 2, 3})
+	s3 := From([]int{4, 5})
+
+	assert.True(t, s1.IsSubsetOf(s2))
+	assert.True(t, s1.IsSubsetOf(s1))
+	assert.False(t, s2.IsSubsetOf(s1))
+	assert.True(t, s1.IsStrictSubsetOf(s2))
+	assert.False(t, s1.IsStrictSubsetOf(s1))
+	assert.True(t, s2.IsSupersetOf(s1))
+	assert.False(t, s1.IsSupersetOf(s2))
+	assert.True(t, New[int](0).IsSubsetOf(s1))
+
+	assert.True(t, s1.IsDisjoint(s3))
+	assert.False(t, s1.IsDisjoint(s2))
+	assert.True(t, New[int](0).IsDisjoint(s1))
+}
+
+func TestUnionAll(t *testing.T) {
+	result := UnionAll(From([]int{1, 2}), From([]int{2, 3}), From([]int{5}))
+	assert.True(t, result.EqualSlice([]int{1, 2, 3, 5}))
+	assert.True(t, UnionAll[int]().Empty())
+}
+
+func TestIntersectAll(t *testing.T) {
+	result := IntersectAll(From([]int{1, 2, 3, 4}), From([]int{2, 3, 4}), From([]int{3, 4, 5}))
+	assert.True(t, result.EqualSlice([]int{3, 4}))
+	assert.True(t, IntersectAll[int]().Empty())
+	assert.True(t, IntersectAll(From([]int{1}), New[int](0)).Empty())
+}
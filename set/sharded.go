@@ -0,0 +1,193 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"hash/maphash"
+	"sync"
+	"unsafe"
+)
+
+// cacheLine - the size of a CPU cache line, in bytes
+const cacheLine = 64
+
+// shardPadding - the number of bytes padding a shard (a lock and a set pointer) to a multiple of cacheLine
+const shardPadding = (cacheLine - (unsafe.Sizeof(sync.RWMutex{})+unsafe.Sizeof(uintptr(0)))%cacheLine) % cacheLine
+
+// NewSharded - creates a new thread-safe Sharded set, split into the given number of shards,
+// with pre-allocated memory for the given (total) size
+func NewSharded[T comparable](shards int, size int) *Sharded[T] {
+	shards = max(1, shards)
+
+	s := &Sharded[T]{
+		shards: make([]shard[T], shards),
+		seed:   maphash.MakeSeed(),
+	}
+
+	for index := range s.shards {
+		s.shards[index].set = New[T](size / shards)
+	}
+
+	return s
+}
+
+// shard - represents a single partition of a Sharded set
+//   - mutex sync.RWMutex - the lock guarding the partition
+//   - set *Set[T] - the keys of the partition
+//   - _ [shardPadding]byte - padding, keeping consecutive shards on separate cache lines
+type shard[T comparable] struct {
+	mutex sync.RWMutex
+	set   *Set[T]
+	_     [shardPadding]byte
+}
+
+// Sharded - represents a thread-safe set structure, partitioned into independently locked shards
+// by the hash of the keys, for high-contention membership tests
+//   - shards []shard[T] - the partitions of the set
+//   - seed maphash.Seed - the seed used for hashing the keys
+//
+// NOTE: Operations spanning all the shards (Size, Slice, Snapshot, ForEach) lock one shard at a time,
+// and are not a consistent snapshot under concurrent modifications
+type Sharded[T comparable] struct {
+	shards []shard[T]
+	seed   maphash.Seed
+}
+
+// Insert - inserts the key into the set, and returns
+// true if the set was modified (key didn't exist before), false otherwise
+func (s *Sharded[T]) Insert(key T) bool {
+	shard := s.shardOf(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	return shard.set.Insert(key)
+}
+
+// InsertSlice - inserts each key from the given slice into the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Sharded[T]) InsertSlice(keys []T) bool {
+	modified := false
+
+	for _, key := range keys {
+		if s.Insert(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Remove - removes the key from the set, and returns
+// true if the set was modified (key existed before), false otherwise
+func (s *Sharded[T]) Remove(key T) bool {
+	shard := s.shardOf(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	return shard.set.Remove(key)
+}
+
+// RemoveSlice - removes each key in keys from the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Sharded[T]) RemoveSlice(keys []T) bool {
+	modified := false
+
+	for _, key := range keys {
+		if s.Remove(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Has - returns true if key exists in the set, false otherwise
+func (s *Sharded[T]) Has(key T) bool {
+	shard := s.shardOf(key)
+	shard.mutex.RLock()
+	defer shard.mutex.RUnlock()
+	return shard.set.Has(key)
+}
+
+// HasSlice - returns true if all keys are present in the set, false otherwise
+//
+// NOTE: This method will return true for an empty slice
+func (s *Sharded[T]) HasSlice(keys []T) bool {
+	for _, key := range keys {
+		if !s.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Size - returns the cardinality of the set
+func (s *Sharded[T]) Size() int {
+	size := 0
+
+	for index := range s.shards {
+		shard := &s.shards[index]
+		shard.mutex.RLock()
+		size += shard.set.Size()
+		shard.mutex.RUnlock()
+	}
+
+	return size
+}
+
+// Empty - returns true if the set contains no elements, false otherwise
+func (s *Sharded[T]) Empty() bool {
+	return s.Size() == 0
+}
+
+// Snapshot - returns a copy of the current set as a plain (non thread-safe) Set
+func (s *Sharded[T]) Snapshot() *Set[T] {
+	result := New[T](0)
+
+	for index := range s.shards {
+		shard := &s.shards[index]
+		shard.mutex.RLock()
+		result.InsertSet(shard.set)
+		shard.mutex.RUnlock()
+	}
+
+	return result
+}
+
+// Slice - returns a copy of the current set as a slice
+func (s *Sharded[T]) Slice() []T {
+	return s.Snapshot().Slice()
+}
+
+// ForEach - iterates over the set, calling the given function `f` for each key
+//
+// WARNING: The function is called while holding the read lock of a shard, and must not modify the set
+func (s *Sharded[T]) ForEach(f func(T)) {
+	for index := range s.shards {
+		shard := &s.shards[index]
+		shard.mutex.RLock()
+		shard.set.ForEach(f)
+		shard.mutex.RUnlock()
+	}
+}
+
+// shardOf - returns the shard owning the given key
+func (s *Sharded[T]) shardOf(key T) *shard[T] {
+	return &s.shards[maphash.Comparable(s.seed, key)%uint64(len(s.shards))]
+}
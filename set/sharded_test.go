@@ -0,0 +1,58 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharded_Concurrent(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+	set := NewSharded[uint](16, defaultSize)
+
+	var group sync.WaitGroup
+	for worker := range 8 {
+		group.Go(func() {
+			for index := worker; index < len(values); index += 8 {
+				assert.True(t, set.Insert(values[index]))
+				assert.True(t, set.Has(values[index]))
+			}
+		})
+	}
+	group.Wait()
+
+	assert.Equal(t, defaultSize, set.Size())
+	assert.True(t, set.HasSlice(values))
+	assert.True(t, set.Snapshot().EqualSlice(values))
+
+	assert.True(t, set.RemoveSlice(values[:defaultSize/2]))
+	assert.False(t, set.Has(values[0]))
+	assert.ElementsMatch(t, values[defaultSize/2:], set.Slice())
+}
+
+func TestSharded_Padding(t *testing.T) {
+	assert.Zero(t, unsafe.Sizeof(shard[uint]{})%cacheLine)
+	assert.Zero(t, unsafe.Sizeof(shard[string]{})%cacheLine)
+}
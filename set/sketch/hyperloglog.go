@@ -0,0 +1,207 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package sketch
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/andrei-cosmin/sandata/set/filter"
+)
+
+const (
+	// MinPrecision - the minimum precision of a HyperLogLog sketch (16 registers)
+	MinPrecision = 4
+
+	// MaxPrecision - the maximum precision of a HyperLogLog sketch (262144 registers)
+	MaxPrecision = 18
+)
+
+// NewHyperLogLog - creates a new HyperLogLog sketch with 2^precision registers
+//
+// NOTE: The precision is clamped to [MinPrecision, MaxPrecision], and the standard error of the
+// estimates is about 1.04 / sqrt(2^precision) (0.81% for a precision of 14)
+func NewHyperLogLog[T any](precision uint8, hash filter.Hash[T]) *HyperLogLog[T] {
+	precision = min(max(precision, MinPrecision), MaxPrecision)
+
+	return &HyperLogLog[T]{
+		registers: make([]uint8, 1<<precision),
+		precision: precision,
+		hash:      hash,
+	}
+}
+
+// HyperLogLog - represents a HyperLogLog sketch, which estimates the number of distinct keys inserted
+//   - registers []uint8 - the maximum rank observed for each register
+//   - precision uint8 - the number of hash bits selecting the register
+//   - hash filter.Hash[T] - the hash function of the keys
+type HyperLogLog[T any] struct {
+	registers []uint8
+	precision uint8
+	hash      filter.Hash[T]
+}
+
+// Insert - inserts the key into the sketch, and returns
+// true if the sketch was modified, false otherwise
+func (h *HyperLogLog[T]) Insert(key T) bool {
+	hash := h.hash(key)
+	index := hash >> (64 - h.precision)
+	rank := uint8(bits.LeadingZeros64(hash<<h.precision|1<<(h.precision-1))) + 1
+
+	if rank <= h.registers[index] {
+		return false
+	}
+
+	h.registers[index] = rank
+	return true
+}
+
+// InsertSlice - inserts each key from the given slice into the sketch, and returns
+// true if the sketch was modified (at least once), false otherwise
+func (h *HyperLogLog[T]) InsertSlice(keys []T) bool {
+	modified := false
+
+	for _, key := range keys {
+		if h.Insert(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Count - returns the estimated number of distinct keys inserted into the sketch
+func (h *HyperLogLog[T]) Count() uint64 {
+	registers := float64(len(h.registers))
+	sum, zeros := 0.0, 0
+
+	for _, rank := range h.registers {
+		sum += math.Ldexp(1, -int(rank))
+		if rank == 0 {
+			zeros++
+		}
+	}
+
+	estimate := h.alpha() * registers * registers / sum
+
+	// Small range correction (linear counting)
+	if estimate <= 2.5*registers && zeros > 0 {
+		estimate = registers * math.Log(registers/float64(zeros))
+	}
+
+	return uint64(estimate + 0.5)
+}
+
+// Empty - returns true if no key was inserted into the sketch, false otherwise
+func (h *HyperLogLog[T]) Empty() bool {
+	for _, rank := range h.registers {
+		if rank != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Precision - returns the precision of the sketch
+func (h *HyperLogLog[T]) Precision() uint8 {
+	return h.precision
+}
+
+// Merge - merges the keys of the given sketch into the current sketch
+//
+// NOTE: The sketches must have the same precision (and use the same hash function), otherwise
+// ErrIncompatible is returned
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h.precision != other.precision {
+		return ErrIncompatible
+	}
+
+	for index, rank := range other.registers {
+		h.registers[index] = max(h.registers[index], rank)
+	}
+
+	return nil
+}
+
+// Union - returns a new sketch of the union of the keys of both sketches
+//
+//	result = sketch.union(other) =>  count(result) ~ | set ∪ other |
+func (h *HyperLogLog[T]) Union(other *HyperLogLog[T]) (*HyperLogLog[T], error) {
+	result := h.Copy()
+	if err := result.Merge(other); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UnionCount - returns the estimated number of distinct keys of the union of both sketches
+//
+//	| set ∪ other |
+func (h *HyperLogLog[T]) UnionCount(other *HyperLogLog[T]) (uint64, error) {
+	union, err := h.Union(other)
+	if err != nil {
+		return 0, err
+	}
+
+	return union.Count(), nil
+}
+
+// IntersectCount - returns the estimated number of distinct keys present in both sketches,
+// using the inclusion-exclusion principle
+//
+//	| set ∩ other | = | set | + | other | - | set ∪ other |
+//
+// NOTE: The error of the estimate is relative to the size of the union, so small intersections
+// of large sets are imprecise (see MinHash)
+func (h *HyperLogLog[T]) IntersectCount(other *HyperLogLog[T]) (uint64, error) {
+	union, err := h.UnionCount(other)
+	if err != nil {
+		return 0, err
+	}
+
+	sum := h.Count() + other.Count()
+	if sum <= union {
+		return 0, nil
+	}
+
+	return min(sum-union, h.Count(), other.Count()), nil
+}
+
+// Copy - returns a copy of the current sketch
+func (h *HyperLogLog[T]) Copy() *HyperLogLog[T] {
+	other := *h
+	other.registers = append([]uint8(nil), h.registers...)
+	return &other
+}
+
+// alpha - returns the bias correction constant for the number of registers of the sketch
+func (h *HyperLogLog[T]) alpha() float64 {
+	switch len(h.registers) {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/float64(len(h.registers)))
+	}
+}
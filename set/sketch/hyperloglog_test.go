@@ -0,0 +1,74 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package sketch
+
+import (
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/set/filter"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	defaultSize = 100000
+	precision   = 14
+	tolerance   = 0.03
+)
+
+func TestHyperLogLog_Count(t *testing.T) {
+	sketch := NewHyperLogLog(precision, filter.HashInteger[int])
+	assert.True(t, sketch.Empty())
+	assert.Equal(t, uint64(0), sketch.Count())
+
+	for key := range defaultSize {
+		sketch.Insert(key)
+		sketch.Insert(key)
+	}
+
+	assert.InEpsilon(t, defaultSize, sketch.Count(), tolerance)
+
+	small := NewHyperLogLog(precision, filter.HashInteger[int])
+	small.InsertSlice([]int{1, 2, 3, 2, 1})
+	assert.Equal(t, uint64(3), small.Count())
+}
+
+func TestHyperLogLog_Union(t *testing.T) {
+	s1 := NewHyperLogLog(precision, filter.HashInteger[int])
+	s2 := NewHyperLogLog(precision, filter.HashInteger[int])
+
+	for key := range defaultSize {
+		s1.Insert(key)
+		s2.Insert(key + defaultSize/2)
+	}
+
+	union, err := s1.UnionCount(s2)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, 3*defaultSize/2, union, tolerance)
+
+	intersect, err := s1.IntersectCount(s2)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, defaultSize/2, intersect, 3*tolerance)
+
+	assert.NoError(t, s1.Merge(s2))
+	assert.Equal(t, union, s1.Count())
+
+	_, err = s1.Union(NewHyperLogLog(precision-1, filter.HashInteger[int]))
+	assert.ErrorIs(t, err, ErrIncompatible)
+}
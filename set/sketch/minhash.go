@@ -0,0 +1,175 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package sketch
+
+import (
+	"math"
+
+	"github.com/andrei-cosmin/sandata/set/filter"
+)
+
+// NewMinHash - creates a new MinHash signature with the given number of hash functions
+//
+// NOTE: The standard error of the similarity estimates is about 1 / sqrt(hashes)
+func NewMinHash[T any](hashes int, hash filter.Hash[T]) *MinHash[T] {
+	minimums := make([]uint64, max(hashes, 1))
+	for index := range minimums {
+		minimums[index] = math.MaxUint64
+	}
+
+	return &MinHash[T]{
+		minimums: minimums,
+		hash:     hash,
+	}
+}
+
+// MinHash - represents a MinHash signature, which estimates the Jaccard similarity between sets
+//   - minimums []uint64 - the minimum hash observed for each hash function
+//   - hash filter.Hash[T] - the hash function of the keys
+type MinHash[T any] struct {
+	minimums []uint64
+	hash     filter.Hash[T]
+}
+
+// Insert - inserts the key into the signature, and returns
+// true if the signature was modified, false otherwise
+func (m *MinHash[T]) Insert(key T) bool {
+	modified := false
+	hash := m.hash(key)
+
+	for index, minimum := range m.minimums {
+		if value := permute(hash, index); value < minimum {
+			m.minimums[index] = value
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// InsertSlice - inserts each key from the given slice into the signature, and returns
+// true if the signature was modified (at least once), false otherwise
+func (m *MinHash[T]) InsertSlice(keys []T) bool {
+	modified := false
+
+	for _, key := range keys {
+		if m.Insert(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Empty - returns true if no key was inserted into the signature, false otherwise
+func (m *MinHash[T]) Empty() bool {
+	for _, minimum := range m.minimums {
+		if minimum != math.MaxUint64 {
+			return false
+		}
+	}
+	return true
+}
+
+// Hashes - returns the number of hash functions of the signature
+func (m *MinHash[T]) Hashes() int {
+	return len(m.minimums)
+}
+
+// Jaccard - returns the estimated Jaccard similarity between the sets of the two signatures
+//
+//	J(set, other) = | set ∩ other | / | set ∪ other |
+//
+// NOTE: The signatures must have the same number of hash functions (and use the same hash function),
+// otherwise ErrIncompatible is returned
+func (m *MinHash[T]) Jaccard(other *MinHash[T]) (float64, error) {
+	if len(m.minimums) != len(other.minimums) {
+		return 0, ErrIncompatible
+	}
+
+	if m.Empty() && other.Empty() {
+		return 1, nil
+	}
+
+	equal := 0
+	for index, minimum := range m.minimums {
+		if minimum == other.minimums[index] {
+			equal++
+		}
+	}
+
+	return float64(equal) / float64(len(m.minimums)), nil
+}
+
+// Merge - merges the keys of the given signature into the current signature
+//
+// NOTE: The signatures must have the same number of hash functions (and use the same hash function),
+// otherwise ErrIncompatible is returned
+func (m *MinHash[T]) Merge(other *MinHash[T]) error {
+	if len(m.minimums) != len(other.minimums) {
+		return ErrIncompatible
+	}
+
+	for index, minimum := range other.minimums {
+		m.minimums[index] = min(m.minimums[index], minimum)
+	}
+
+	return nil
+}
+
+// Union - returns a new signature of the union of the keys of both signatures
+//
+//	result = signature.union(other) =>  result ~ set ∪ other
+func (m *MinHash[T]) Union(other *MinHash[T]) (*MinHash[T], error) {
+	result := m.Copy()
+	if err := result.Merge(other); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// IntersectCount - returns the estimated number of keys present in both sets, given the (exact or
+// estimated, e.g. by HyperLogLog.UnionCount) size of their union
+//
+//	| set ∩ other | = J(set, other) * | set ∪ other |
+func (m *MinHash[T]) IntersectCount(other *MinHash[T], unionCount uint64) (uint64, error) {
+	similarity, err := m.Jaccard(other)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(similarity*float64(unionCount) + 0.5), nil
+}
+
+// Copy - returns a copy of the current signature
+func (m *MinHash[T]) Copy() *MinHash[T] {
+	other := *m
+	other.minimums = append([]uint64(nil), m.minimums...)
+	return &other
+}
+
+// permute - returns the hash of the key under the hash function of the given index
+//
+// NOTE: The hash functions only depend on their index, so signatures built in different processes
+// are comparable
+func permute(hash uint64, index int) uint64 {
+	return filter.HashInteger(hash ^ filter.HashInteger(uint64(index)+0x9e3779b97f4a7c15))
+}
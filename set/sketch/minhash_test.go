@@ -0,0 +1,76 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package sketch
+
+import (
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/set/filter"
+	"github.com/stretchr/testify/assert"
+)
+
+const hashes = 512
+
+func TestMinHash_Jaccard(t *testing.T) {
+	s1 := NewMinHash(hashes, filter.HashInteger[int])
+	s2 := NewMinHash(hashes, filter.HashInteger[int])
+
+	similarity, err := s1.Jaccard(s2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, similarity)
+
+	// | s1 ∩ s2 | = 5000, | s1 ∪ s2 | = 15000
+	for key := range 10000 {
+		s1.Insert(key)
+		s2.Insert(key + 5000)
+	}
+
+	similarity, err = s1.Jaccard(s2)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.0/3, similarity, 0.07)
+
+	intersect, err := s1.IntersectCount(s2, 15000)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, 5000, intersect, 0.2)
+
+	similarity, err = s1.Jaccard(s1.Copy())
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, similarity)
+}
+
+func TestMinHash_Union(t *testing.T) {
+	s1 := NewMinHash(hashes, filter.HashString)
+	s2 := NewMinHash(hashes, filter.HashString)
+	s1.InsertSlice([]string{"a", "b"})
+	s2.InsertSlice([]string{"c"})
+
+	union, err := s1.Union(s2)
+	assert.NoError(t, err)
+
+	expected := NewMinHash(hashes, filter.HashString)
+	expected.InsertSlice([]string{"a", "b", "c"})
+
+	similarity, err := union.Jaccard(expected)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, similarity)
+
+	_, err = s1.Union(NewMinHash(hashes/2, filter.HashString))
+	assert.ErrorIs(t, err, ErrIncompatible)
+}
@@ -0,0 +1,637 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+	"slices"
+)
+
+// maxLevel - the maximum number of levels of a Sorted set (enough for 4^32 keys)
+const maxLevel = 32
+
+// sortedLink - represents a forward link of a skip list node
+//   - node *sortedNode[T] - the node the link points to (nil for the end of the level)
+//   - width int - the number of level 0 steps covered by the link (meaningless when node is nil)
+type sortedLink[T cmp.Ordered] struct {
+	node  *sortedNode[T]
+	width int
+}
+
+// sortedNode - represents a skip list node
+//   - key T - the key stored in the node
+//   - next []sortedLink[T] - the forward links of the node, one for each of its levels
+type sortedNode[T cmp.Ordered] struct {
+	key  T
+	next []sortedLink[T]
+}
+
+// NewSorted - creates a new empty Sorted set
+func NewSorted[T cmp.Ordered]() *Sorted[T] {
+	return &Sorted[T]{
+		head:  &sortedNode[T]{next: make([]sortedLink[T], maxLevel)},
+		level: 1,
+	}
+}
+
+// SortedFrom - creates a new Sorted set containing each key in the given slice
+func SortedFrom[T cmp.Ordered](keys []T) *Sorted[T] {
+	sorted := slices.Clone(keys)
+	slices.Sort(sorted)
+
+	builder := newSortedBuilder[T]()
+	for _, key := range slices.Compact(sorted) {
+		builder.append(key)
+	}
+
+	return builder.result
+}
+
+// Sorted - represents an ordered set structure, backed by an indexable skip list, which supports
+// order-statistics (Rank, Select) and range queries (Floor, Ceiling, Range) in O(log n)
+//   - head *sortedNode[T] - the sentinel node preceding the smallest key
+//   - level int - the number of levels currently in use
+//   - size int - the cardinality of the set
+type Sorted[T cmp.Ordered] struct {
+	head  *sortedNode[T]
+	level int
+	size  int
+}
+
+// Insert - inserts the key into the set, and returns
+// true if the set was modified (key didn't exist before), false otherwise
+func (s *Sorted[T]) Insert(key T) bool {
+	var update [maxLevel]*sortedNode[T]
+	var rank [maxLevel]int
+	s.predecessors(key, &update, &rank)
+
+	if next := update[0].next[0].node; next != nil && next.key == key {
+		return false
+	}
+
+	level := randomLevel()
+	for index := s.level; index < level; index++ {
+		update[index] = s.head
+		rank[index] = 0
+	}
+	s.level = max(s.level, level)
+
+	node := &sortedNode[T]{
+		key:  key,
+		next: make([]sortedLink[T], level),
+	}
+
+	for index := range level {
+		link := &update[index].next[index]
+		steps := rank[0] - rank[index]
+
+		node.next[index] = sortedLink[T]{node: link.node, width: link.width - steps}
+		*link = sortedLink[T]{node: node, width: steps + 1}
+	}
+
+	for index := level; index < s.level; index++ {
+		update[index].next[index].width++
+	}
+
+	s.size++
+	return true
+}
+
+// InsertSlice - inserts each key from the given slice into the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Sorted[T]) InsertSlice(keys []T) bool {
+	modified := false
+
+	for _, key := range keys {
+		if s.Insert(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// InsertSet - inserts each element of the given set into the current set, and returns
+// true if the current set was modified (at least once), false otherwise
+func (s *Sorted[T]) InsertSet(other *Sorted[T]) bool {
+	modified := false
+
+	for node := other.head.next[0].node; node != nil; node = node.next[0].node {
+		if s.Insert(node.key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Remove - removes the key from the set, and returns
+// true if the set was modified (key existed before), false otherwise
+func (s *Sorted[T]) Remove(key T) bool {
+	var update [maxLevel]*sortedNode[T]
+	var rank [maxLevel]int
+	s.predecessors(key, &update, &rank)
+
+	node := update[0].next[0].node
+	if node == nil || node.key != key {
+		return false
+	}
+
+	for index := range s.level {
+		link := &update[index].next[index]
+
+		if link.node == node {
+			link.node = node.next[index].node
+			link.width += node.next[index].width - 1
+		} else {
+			link.width--
+		}
+	}
+
+	for s.level > 1 && s.head.next[s.level-1].node == nil {
+		s.level--
+	}
+
+	s.size--
+	return true
+}
+
+// RemoveSlice - removes each key in keys from the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Sorted[T]) RemoveSlice(keys []T) bool {
+	modified := false
+
+	for _, key := range keys {
+		if s.Remove(key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// RemoveSet - removes each element of the given set from the current set, and returns
+// true if the current set was modified (at least once), false otherwise
+//
+// NOTE: A removed node keeps its links, so the set can be removed from itself
+func (s *Sorted[T]) RemoveSet(other *Sorted[T]) bool {
+	modified := false
+
+	for node := other.head.next[0].node; node != nil; node = node.next[0].node {
+		if s.Remove(node.key) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Has - returns true if key exists in the set, false otherwise
+func (s *Sorted[T]) Has(key T) bool {
+	node := s.lastLess(key).next[0].node
+	return node != nil && node.key == key
+}
+
+// HasSlice - returns true if all keys are present in the set, false otherwise
+//
+// NOTE: This method will return true for an empty slice
+func (s *Sorted[T]) HasSlice(keys []T) bool {
+	for _, key := range keys {
+		if !s.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasSet - returns true if all keys from the other set are present in the current set, false otherwise
+//
+// NOTE: This method will return true for an empty given set
+func (s *Sorted[T]) HasSet(other *Sorted[T]) bool {
+	if other.size > s.size {
+		return false
+	}
+
+	cursor := s.head.next[0].node
+	for node := other.head.next[0].node; node != nil; node = node.next[0].node {
+		for cursor != nil && cursor.key < node.key {
+			cursor = cursor.next[0].node
+		}
+
+		if cursor == nil || cursor.key != node.key {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FilterFunc - filters the set using the given filter function, and returns
+// true if the set was modified, false otherwise
+func (s *Sorted[T]) FilterFunc(filter func(T) bool) bool {
+	builder := newSortedBuilder[T]()
+
+	for node := s.head.next[0].node; node != nil; node = node.next[0].node {
+		if filter(node.key) {
+			builder.append(node.key)
+		}
+	}
+
+	modified := builder.result.size != s.size
+	*s = *builder.result
+	return modified
+}
+
+// Size - returns the cardinality of the set
+func (s *Sorted[T]) Size() int {
+	return s.size
+}
+
+// Empty - returns true if the set contains no elements, false otherwise
+func (s *Sorted[T]) Empty() bool {
+	return s.size == 0
+}
+
+// Min - returns the smallest key of the set, and false if the set is empty
+func (s *Sorted[T]) Min() (T, bool) {
+	return keyOf(s.head.next[0].node)
+}
+
+// Max - returns the largest key of the set, and false if the set is empty
+func (s *Sorted[T]) Max() (T, bool) {
+	cursor := s.head
+
+	for index := s.level - 1; index >= 0; index-- {
+		for cursor.next[index].node != nil {
+			cursor = cursor.next[index].node
+		}
+	}
+
+	return s.keyOrNone(cursor)
+}
+
+// Floor - returns the largest key of the set less than or equal to the given key, and false if there is none
+func (s *Sorted[T]) Floor(key T) (T, bool) {
+	return s.keyOrNone(s.lastLessOrEqual(key))
+}
+
+// Ceiling - returns the smallest key of the set greater than or equal to the given key, and false if there is none
+func (s *Sorted[T]) Ceiling(key T) (T, bool) {
+	return keyOf(s.lastLess(key).next[0].node)
+}
+
+// Lower - returns the largest key of the set strictly less than the given key, and false if there is none
+func (s *Sorted[T]) Lower(key T) (T, bool) {
+	return s.keyOrNone(s.lastLess(key))
+}
+
+// Higher - returns the smallest key of the set strictly greater than the given key, and false if there is none
+func (s *Sorted[T]) Higher(key T) (T, bool) {
+	return keyOf(s.lastLessOrEqual(key).next[0].node)
+}
+
+// Range - returns an ordered iterator over the keys of the set in the half-open interval [lo, hi)
+func (s *Sorted[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := s.lastLess(lo).next[0].node; node != nil && node.key < hi; node = node.next[0].node {
+			if !yield(node.key) {
+				return
+			}
+		}
+	}
+}
+
+// Rank - returns the number of keys of the set strictly less than the given key
+//
+// NOTE: When the key is present in the set, its rank is its (0-based) position in ascending order
+func (s *Sorted[T]) Rank(key T) int {
+	cursor, rank := s.head, 0
+
+	for index := s.level - 1; index >= 0; index-- {
+		for link := cursor.next[index]; link.node != nil && link.node.key < key; link = cursor.next[index] {
+			rank += link.width
+			cursor = link.node
+		}
+	}
+
+	return rank
+}
+
+// Select - returns the key at the given (0-based) position in ascending order, and false if the position
+// is out of range
+//
+//	s.Select(s.Rank(key)) => key, true (for each key in s)
+func (s *Sorted[T]) Select(position int) (T, bool) {
+	if position < 0 || position >= s.size {
+		var zero T
+		return zero, false
+	}
+
+	cursor, steps := s.head, 0
+	for index := s.level - 1; index >= 0; index-- {
+		for link := cursor.next[index]; link.node != nil && steps+link.width <= position+1; link = cursor.next[index] {
+			steps += link.width
+			cursor = link.node
+		}
+	}
+
+	return cursor.key, true
+}
+
+// All - returns an iterator over the keys of the set in ascending order
+func (s *Sorted[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := s.head.next[0].node; node != nil; node = node.next[0].node {
+			if !yield(node.key) {
+				return
+			}
+		}
+	}
+}
+
+// Backward - returns an iterator over the keys of the set in descending order
+//
+// NOTE: The skip list has no backward links, so the iteration costs O(log n) per key
+func (s *Sorted[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for position := s.size - 1; position >= 0; position-- {
+			key, _ := s.Select(position)
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Union - returns new set representing the union of the current and given sets
+//
+//	result = set.union(other) =>  result <- set ∪ other
+func (s *Sorted[T]) Union(other *Sorted[T]) *Sorted[T] {
+	return s.merge(other, true, true, true)
+}
+
+// Difference - returns a set representing the difference between the current and given sets
+//
+//	result = set.difference(other) =>  result <- set \ other
+func (s *Sorted[T]) Difference(other *Sorted[T]) *Sorted[T] {
+	return s.merge(other, true, false, false)
+}
+
+// Intersect - returns a set representing the intersection of the current and given set
+//
+//	result = set.intersect(other) =>  result <- set ∩ other
+func (s *Sorted[T]) Intersect(other *Sorted[T]) *Sorted[T] {
+	return s.merge(other, false, true, false)
+}
+
+// SymmetricDifference - returns a set representing the keys present in exactly one of the current and given sets
+//
+//	result = set.symmetricDifference(other) =>  result <- (set \ other) ∪ (other \ set)
+func (s *Sorted[T]) SymmetricDifference(other *Sorted[T]) *Sorted[T] {
+	return s.merge(other, true, false, true)
+}
+
+// IsSubsetOf - returns true if all keys from the current set are present in the other set, false otherwise
+func (s *Sorted[T]) IsSubsetOf(other *Sorted[T]) bool {
+	return other.HasSet(s)
+}
+
+// IsStrictSubsetOf - returns true if the current set is a subset of the other set,
+// and the other set contains at least one key which is not present in the current set, false otherwise
+func (s *Sorted[T]) IsStrictSubsetOf(other *Sorted[T]) bool {
+	return s.size < other.size && other.HasSet(s)
+}
+
+// IsSupersetOf - returns true if all keys from the other set are present in the current set, false otherwise
+func (s *Sorted[T]) IsSupersetOf(other *Sorted[T]) bool {
+	return s.HasSet(other)
+}
+
+// IsDisjoint - returns true if the current and given sets have no keys in common, false otherwise
+//
+// NOTE: Both sets are walked in ascending order, stopping at the first common key
+func (s *Sorted[T]) IsDisjoint(other *Sorted[T]) bool {
+	left, right := s.head.next[0].node, other.head.next[0].node
+
+	for left != nil && right != nil {
+		switch {
+		case left.key < right.key:
+			left = left.next[0].node
+		case right.key < left.key:
+			right = right.next[0].node
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// Copy - returns a copy of the current set
+func (s *Sorted[T]) Copy() *Sorted[T] {
+	return s.merge(NewSorted[T](), true, false, false)
+}
+
+// Slice - returns a copy of the current set as a slice, in ascending order
+func (s *Sorted[T]) Slice() []T {
+	keys := make([]T, 0, s.size)
+	for node := s.head.next[0].node; node != nil; node = node.next[0].node {
+		keys = append(keys, node.key)
+	}
+	return keys
+}
+
+// Set - returns a copy of the current set as a hash based Set
+func (s *Sorted[T]) Set() *Set[T] {
+	return From(s.Slice())
+}
+
+// Equal - returns true if the sets have the same size and contain the same keys, false otherwise
+func (s *Sorted[T]) Equal(other *Sorted[T]) bool {
+	return s.size == other.size && s.HasSet(other)
+}
+
+// EqualSlice - returns true if the current set and given keys contain exactly the same keys.
+func (s *Sorted[T]) EqualSlice(items []T) bool {
+	if len(items) != s.size {
+		return false
+	}
+	return s.HasSlice(items)
+}
+
+// ForEach - iterates over the set in ascending order, calling the given function `f` for each key
+func (s *Sorted[T]) ForEach(f func(T)) {
+	for node := s.head.next[0].node; node != nil; node = node.next[0].node {
+		f(node.key)
+	}
+}
+
+// merge - walks both sets in ascending order and builds a new set, keeping the keys present only in the
+// current set (left), in both sets (both), and only in the given set (right)
+func (s *Sorted[T]) merge(other *Sorted[T], left, both, right bool) *Sorted[T] {
+	builder := newSortedBuilder[T]()
+	n1, n2 := s.head.next[0].node, other.head.next[0].node
+
+	for n1 != nil && n2 != nil {
+		switch {
+		case n1.key < n2.key:
+			builder.appendIf(left, n1.key)
+			n1 = n1.next[0].node
+		case n2.key < n1.key:
+			builder.appendIf(right, n2.key)
+			n2 = n2.next[0].node
+		default:
+			builder.appendIf(both, n1.key)
+			n1, n2 = n1.next[0].node, n2.next[0].node
+		}
+	}
+
+	for ; n1 != nil; n1 = n1.next[0].node {
+		builder.appendIf(left, n1.key)
+	}
+
+	for ; n2 != nil; n2 = n2.next[0].node {
+		builder.appendIf(right, n2.key)
+	}
+
+	return builder.result
+}
+
+// predecessors - fills in, for each level, the last node with a key strictly less than the given key,
+// along with its position (the head being at position 0)
+func (s *Sorted[T]) predecessors(key T, update *[maxLevel]*sortedNode[T], rank *[maxLevel]int) {
+	cursor, position := s.head, 0
+
+	for index := s.level - 1; index >= 0; index-- {
+		for link := cursor.next[index]; link.node != nil && link.node.key < key; link = cursor.next[index] {
+			position += link.width
+			cursor = link.node
+		}
+
+		update[index] = cursor
+		rank[index] = position
+	}
+}
+
+// lastLess - returns the last node with a key strictly less than the given key (or the head)
+func (s *Sorted[T]) lastLess(key T) *sortedNode[T] {
+	cursor := s.head
+
+	for index := s.level - 1; index >= 0; index-- {
+		for next := cursor.next[index].node; next != nil && next.key < key; next = cursor.next[index].node {
+			cursor = next
+		}
+	}
+
+	return cursor
+}
+
+// lastLessOrEqual - returns the last node with a key less than or equal to the given key (or the head)
+func (s *Sorted[T]) lastLessOrEqual(key T) *sortedNode[T] {
+	cursor := s.head
+
+	for index := s.level - 1; index >= 0; index-- {
+		for next := cursor.next[index].node; next != nil && next.key <= key; next = cursor.next[index].node {
+			cursor = next
+		}
+	}
+
+	return cursor
+}
+
+// keyOrNone - returns the key of the given node, and false if the node is the head
+func (s *Sorted[T]) keyOrNone(node *sortedNode[T]) (T, bool) {
+	if node == s.head {
+		var zero T
+		return zero, false
+	}
+	return node.key, true
+}
+
+// keyOf - returns the key of the given node, and false if the node is nil
+func keyOf[T cmp.Ordered](node *sortedNode[T]) (T, bool) {
+	if node == nil {
+		var zero T
+		return zero, false
+	}
+	return node.key, true
+}
+
+// randomLevel - returns a random level for a new node, with a probability of 1/4 to promote each level
+func randomLevel() int {
+	level := 1
+	for level < maxLevel && rand.Uint32()&3 == 0 {
+		level++
+	}
+	return level
+}
+
+// sortedBuilder - builds a Sorted set in O(n) from keys given in strictly ascending order
+//   - result *Sorted[T] - the set being built
+//   - last [maxLevel]*sortedNode[T] - the last node on each level
+//   - rank [maxLevel]int - the position of the last node on each level
+type sortedBuilder[T cmp.Ordered] struct {
+	result *Sorted[T]
+	last   [maxLevel]*sortedNode[T]
+	rank   [maxLevel]int
+}
+
+// newSortedBuilder - creates a new builder over an empty Sorted set
+func newSortedBuilder[T cmp.Ordered]() *sortedBuilder[T] {
+	builder := &sortedBuilder[T]{
+		result: NewSorted[T](),
+	}
+
+	for index := range maxLevel {
+		builder.last[index] = builder.result.head
+	}
+
+	return builder
+}
+
+// append - appends the key at the end of the set
+//
+// NOTE: The caller is responsible to ensure that the key is greater than all the previously appended keys
+func (b *sortedBuilder[T]) append(key T) {
+	level := randomLevel()
+	node := &sortedNode[T]{
+		key:  key,
+		next: make([]sortedLink[T], level),
+	}
+
+	b.result.size++
+	b.result.level = max(b.result.level, level)
+
+	for index := range level {
+		b.last[index].next[index] = sortedLink[T]{node: node, width: b.result.size - b.rank[index]}
+		b.last[index] = node
+		b.rank[index] = b.result.size
+	}
+}
+
+// appendIf - appends the key at the end of the set, if the condition holds
+func (b *sortedBuilder[T]) appendIf(condition bool, key T) {
+	if condition {
+		b.append(key)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSorted_InsertRemove(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+	set := NewSorted[uint]()
+
+	for _, value := range values {
+		assert.True(t, set.Insert(value))
+		assert.False(t, set.Insert(value))
+	}
+
+	assert.Equal(t, defaultSize, set.Size())
+	assert.True(t, set.EqualSlice(values))
+	assert.True(t, slices.IsSorted(set.Slice()))
+
+	assert.True(t, set.RemoveSlice(values[:defaultSize/2]))
+	assert.False(t, set.Remove(values[0]))
+	assert.False(t, set.Has(values[0]))
+
+	expected := slices.Sorted(slices.Values(values[defaultSize/2:]))
+	assert.Equal(t, expected, slices.Collect(set.All()))
+}
+
+func TestSorted_OrderStatistics(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+	set := SortedFrom(values)
+	set.RemoveSlice(values[:defaultSize/4])
+	set.InsertSlice(values[:defaultSize/8])
+
+	expected := set.Slice()
+	for position, key := range expected {
+		assert.Equal(t, position, set.Rank(key))
+
+		selected, ok := set.Select(position)
+		assert.True(t, ok)
+		assert.Equal(t, key, selected)
+	}
+
+	_, ok := set.Select(len(expected))
+	assert.False(t, ok)
+	assert.Equal(t, len(expected), set.Rank(maxRandomValue))
+	assert.Equal(t, 0, set.Rank(0))
+}
+
+func TestSorted_Neighbours(t *testing.T) {
+	set := SortedFrom([]int{10, 20, 30})
+
+	assertKey := func(expected int, key int, ok bool) {
+		assert.True(t, ok)
+		assert.Equal(t, expected, key)
+	}
+
+	minimum, ok := set.Min()
+	assertKey(10, minimum, ok)
+	maximum, ok := set.Max()
+	assertKey(30, maximum, ok)
+
+	key, ok := set.Floor(20)
+	assertKey(20, key, ok)
+	key, ok = set.Floor(25)
+	assertKey(20, key, ok)
+	_, ok = set.Floor(5)
+	assert.False(t, ok)
+
+	key, ok = set.Ceiling(20)
+	assertKey(20, key, ok)
+	key, ok = set.Ceiling(15)
+	assertKey(20, key, ok)
+	_, ok = set.Ceiling(35)
+	assert.False(t, ok)
+
+	key, ok = set.Lower(20)
+	assertKey(10, key, ok)
+	key, ok = set.Higher(20)
+	assertKey(30, key, ok)
+	_, ok = set.Higher(30)
+	assert.False(t, ok)
+
+	assert.Equal(t, []int{20, 30}, slices.Collect(set.Range(11, 31)))
+	assert.Equal(t, []int{10}, slices.Collect(set.Range(10, 20)))
+	assert.Empty(t, slices.Collect(set.Range(21, 30)))
+	assert.Equal(t, []int{30, 20, 10}, slices.Collect(set.Backward()))
+
+	_, ok = NewSorted[int]().Max()
+	assert.False(t, ok)
+}
+
+func TestSorted_Algebra(t *testing.T) {
+	s1 := SortedFrom([]int{1, 2, 3, 4})
+	s2 := SortedFrom([]int{3, 4, 5})
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s1.Union(s2).Slice())
+	assert.Equal(t, []int{1, 2}, s1.Difference(s2).Slice())
+	assert.Equal(t, []int{3, 4}, s1.Intersect(s2).Slice())
+	assert.Equal(t, []int{1, 2, 5}, s1.SymmetricDifference(s2).Slice())
+	assert.True(t, s1.Intersect(s2).IsStrictSubsetOf(s2))
+	assert.False(t, s1.IsDisjoint(s2))
+	assert.True(t, s1.IsDisjoint(SortedFrom([]int{0, 5, 6})))
+	assert.True(t, s1.IsDisjoint(NewSorted[int]()))
+	assert.True(t, s1.Equal(s1.Copy()))
+
+	union := s1.Union(s2)
+	for position := range union.Size() {
+		key, _ := union.Select(position)
+		assert.Equal(t, position+1, key)
+	}
+
+	assert.True(t, s1.InsertSet(s2))
+	assert.False(t, s1.InsertSet(s2))
+	assert.True(t, s1.RemoveSet(SortedFrom([]int{1, 5})))
+	assert.False(t, s1.RemoveSet(SortedFrom([]int{1, 5})))
+	assert.Equal(t, []int{2, 3, 4}, s1.Slice())
+	for position := range s1.Size() {
+		key, _ := s1.Select(position)
+		assert.Equal(t, position+2, key)
+	}
+
+	copied := s1.Copy()
+	assert.True(t, copied.RemoveSet(copied))
+	assert.True(t, copied.Empty())
+
+	assert.True(t, s1.FilterFunc(func(key int) bool { return key%2 == 0 }))
+	assert.Equal(t, []int{2, 4}, s1.Slice())
+	assert.True(t, s1.Set().EqualSlice([]int{2, 4}))
+}
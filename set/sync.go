@@ -0,0 +1,277 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// syncIds - the source of the unique ids used to order the locking of two Sync sets
+var syncIds atomic.Uint64
+
+// NewSync - creates a new thread-safe Sync set with pre-allocated memory for the given size
+func NewSync[T comparable](size int) *Sync[T] {
+	return wrapSync(New[T](size))
+}
+
+// SyncFrom - creates a new thread-safe Sync set containing each key in the given slice
+func SyncFrom[T comparable](keys []T) *Sync[T] {
+	return wrapSync(From(keys))
+}
+
+// Sync - represents a thread-safe set structure, guarded by a read-write mutex
+//   - mutex sync.RWMutex - the lock guarding the set
+//   - set *Set[T] - the underlying set
+//   - id uint64 - the unique id of the set, used to order locks when two sets are involved in an operation
+//
+// NOTE: Operations involving two Sync sets lock both of them (always in the same global order, so they cannot
+// deadlock), and observe a consistent snapshot of both
+type Sync[T comparable] struct {
+	mutex sync.RWMutex
+	set   *Set[T]
+	id    uint64
+}
+
+// Insert - inserts the key into the set, and returns
+// true if the set was modified (key didn't exist before), false otherwise
+func (s *Sync[T]) Insert(key T) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.set.Insert(key)
+}
+
+// InsertSlice - inserts each key from the given slice into the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Sync[T]) InsertSlice(keys []T) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.set.InsertSlice(keys)
+}
+
+// InsertSet - inserts each element of the given set into the current set, and returns
+// true if the current set was modified (at least once), false otherwise
+func (s *Sync[T]) InsertSet(other *Sync[T]) bool {
+	defer lockPair(s, other, true)()
+	return s.set.InsertSet(other.set)
+}
+
+// Remove - removes the key from the set, and returns
+// true if the set was modified (key existed before), false otherwise
+func (s *Sync[T]) Remove(key T) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.set.Remove(key)
+}
+
+// RemoveSlice - removes each key in keys from the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (s *Sync[T]) RemoveSlice(keys []T) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.set.RemoveSlice(keys)
+}
+
+// RemoveSet - removes each element of the given set from the current set, and returns
+// true if the current set was modified (at least once), false otherwise
+func (s *Sync[T]) RemoveSet(other *Sync[T]) bool {
+	defer lockPair(s, other, true)()
+	return s.set.RemoveSet(other.set)
+}
+
+// Has - returns true if key exists in the set, false otherwise
+func (s *Sync[T]) Has(key T) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.Has(key)
+}
+
+// HasSlice - returns true if all keys are present in the set, false otherwise
+//
+// NOTE: This method will return true for an empty slice
+func (s *Sync[T]) HasSlice(keys []T) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.HasSlice(keys)
+}
+
+// HasSet - returns true if all keys from the other set are present in the current set, false otherwise
+//
+// NOTE: This method will return true for an empty given set
+func (s *Sync[T]) HasSet(other *Sync[T]) bool {
+	defer lockPair(s, other, false)()
+	return s.set.HasSet(other.set)
+}
+
+// FilterFunc - filters the set using the given filter function, and returns
+// true if the set was modified, false otherwise
+//
+// WARNING: The filter function is called while holding the write lock, and must not access the set
+func (s *Sync[T]) FilterFunc(filter func(T) bool) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.set.FilterFunc(filter)
+}
+
+// Size - returns the cardinality of the set
+func (s *Sync[T]) Size() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.Size()
+}
+
+// Empty - returns true if the set contains no elements, false otherwise
+func (s *Sync[T]) Empty() bool {
+	return s.Size() == 0
+}
+
+// Union - returns new set representing the union of the current and given sets
+//
+//	result = set.union(other) =>  result <- set ∪ other
+func (s *Sync[T]) Union(other *Sync[T]) *Sync[T] {
+	defer lockPair(s, other, false)()
+	return wrapSync(s.set.Union(other.set))
+}
+
+// Difference - returns a set representing the difference between the current and given sets
+//
+//	result = set.difference(other) =>  result <- set \ other
+func (s *Sync[T]) Difference(other *Sync[T]) *Sync[T] {
+	defer lockPair(s, other, false)()
+	return wrapSync(s.set.Difference(other.set))
+}
+
+// Intersect - returns a set representing the intersection of the current and given set
+//
+//	result = set.intersect(other) =>  result <- set ∩ other
+func (s *Sync[T]) Intersect(other *Sync[T]) *Sync[T] {
+	defer lockPair(s, other, false)()
+	return wrapSync(s.set.Intersect(other.set))
+}
+
+// SymmetricDifference - returns a set representing the keys present in exactly one of the current and given sets
+//
+//	result = set.symmetricDifference(other) =>  result <- (set \ other) ∪ (other \ set)
+func (s *Sync[T]) SymmetricDifference(other *Sync[T]) *Sync[T] {
+	defer lockPair(s, other, false)()
+	return wrapSync(s.set.SymmetricDifference(other.set))
+}
+
+// IsSubsetOf - returns true if all keys from the current set are present in the other set, false otherwise
+func (s *Sync[T]) IsSubsetOf(other *Sync[T]) bool {
+	defer lockPair(s, other, false)()
+	return s.set.IsSubsetOf(other.set)
+}
+
+// IsStrictSubsetOf - returns true if the current set is a subset of the other set,
+// and the other set contains at least one key which is not present in the current set, false otherwise
+func (s *Sync[T]) IsStrictSubsetOf(other *Sync[T]) bool {
+	defer lockPair(s, other, false)()
+	return s.set.IsStrictSubsetOf(other.set)
+}
+
+// IsSupersetOf - returns true if all keys from the other set are present in the current set, false otherwise
+func (s *Sync[T]) IsSupersetOf(other *Sync[T]) bool {
+	defer lockPair(s, other, false)()
+	return s.set.IsSupersetOf(other.set)
+}
+
+// IsDisjoint - returns true if the current and given sets have no keys in common, false otherwise
+func (s *Sync[T]) IsDisjoint(other *Sync[T]) bool {
+	defer lockPair(s, other, false)()
+	return s.set.IsDisjoint(other.set)
+}
+
+// Copy - returns a copy of the current set
+func (s *Sync[T]) Copy() *Sync[T] {
+	return wrapSync(s.Snapshot())
+}
+
+// Snapshot - returns a copy of the current set as a plain (non thread-safe) Set
+func (s *Sync[T]) Snapshot() *Set[T] {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.Copy()
+}
+
+// Slice - returns a copy of the current set as a slice
+func (s *Sync[T]) Slice() []T {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.Slice()
+}
+
+// Equal - returns true if the sets have the same size and contain the same keys, false otherwise
+func (s *Sync[T]) Equal(other *Sync[T]) bool {
+	defer lockPair(s, other, false)()
+	return s.set.Equal(other.set)
+}
+
+// EqualSlice - returns true if the current set and given keys contain exactly the same keys.
+func (s *Sync[T]) EqualSlice(items []T) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.set.EqualSlice(items)
+}
+
+// ForEach - iterates over the set, calling the given function `f` for each key
+//
+// WARNING: The function is called while holding the read lock, and must not modify the set
+func (s *Sync[T]) ForEach(f func(T)) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	s.set.ForEach(f)
+}
+
+// wrapSync - wraps the given set into a new Sync set, taking ownership of it
+func wrapSync[T comparable](set *Set[T]) *Sync[T] {
+	return &Sync[T]{
+		set: set,
+		id:  syncIds.Add(1),
+	}
+}
+
+// lockPair - locks the current set (for writing if write is true, for reading otherwise) and the other set
+// (for reading), always in ascending id order, and returns the function which releases both locks
+func lockPair[T comparable](s *Sync[T], other *Sync[T], write bool) func() {
+	lock, unlock := s.mutex.RLock, s.mutex.RUnlock
+	if write {
+		lock, unlock = s.mutex.Lock, s.mutex.Unlock
+	}
+
+	// The same set is locked only once
+	if s == other {
+		lock()
+		return unlock
+	}
+
+	if s.id < other.id {
+		lock()
+		other.mutex.RLock()
+	} else {
+		other.mutex.RLock()
+		lock()
+	}
+
+	return func() {
+		other.mutex.RUnlock()
+		unlock()
+	}
+}
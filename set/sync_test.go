@@ -0,0 +1,82 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/andrei-cosmin/sandata/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSync_Concurrent(t *testing.T) {
+	values := testutil.RandomUInts(defaultSize, maxRandomValue)
+	set := NewSync[uint](0)
+
+	var group sync.WaitGroup
+	for worker := range 8 {
+		group.Go(func() {
+			for index := worker; index < len(values); index += 8 {
+				set.Insert(values[index])
+				assert.True(t, set.Has(values[index]))
+			}
+		})
+	}
+	group.Wait()
+
+	assert.Equal(t, defaultSize, set.Size())
+	assert.True(t, set.EqualSlice(values))
+}
+
+func TestSync_Algebra(t *testing.T) {
+	s1 := SyncFrom([]int{1, 2, 3, 4})
+	s2 := SyncFrom([]int{3, 4, 5})
+
+	assert.True(t, s1.Union(s2).EqualSlice([]int{1, 2, 3, 4, 5}))
+	assert.True(t, s1.Difference(s2).EqualSlice([]int{1, 2}))
+	assert.True(t, s1.Intersect(s2).EqualSlice([]int{3, 4}))
+	assert.True(t, s2.Intersect(s1).EqualSlice([]int{3, 4}))
+	assert.True(t, s1.SymmetricDifference(s2).EqualSlice([]int{1, 2, 5}))
+	assert.True(t, s1.Union(s1).Equal(s1))
+	assert.True(t, s1.Snapshot().EqualSlice([]int{1, 2, 3, 4}))
+
+	s3 := SyncFrom([]int{3, 4})
+	assert.True(t, s3.IsSubsetOf(s2))
+	assert.True(t, s3.IsStrictSubsetOf(s2))
+	assert.False(t, s3.IsStrictSubsetOf(s3))
+	assert.False(t, s2.IsStrictSubsetOf(s3))
+}
+
+func TestSync_NoDeadlock(t *testing.T) {
+	s1 := SyncFrom(testutil.RandomUInts(defaultSize, maxRandomValue))
+	s2 := SyncFrom(testutil.RandomUInts(defaultSize, maxRandomValue))
+
+	var group sync.WaitGroup
+	for range 100 {
+		group.Go(func() { s1.InsertSet(s2) })
+		group.Go(func() { s2.RemoveSet(s1) })
+		group.Go(func() { s1.Intersect(s2) })
+		group.Go(func() { s2.Union(s1) })
+	}
+	group.Wait()
+
+	assert.True(t, s1.HasSet(s1.Intersect(s2)))
+}
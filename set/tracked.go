@@ -0,0 +1,233 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+// Operation - represents a mutation recorded by a Tracked set
+type Operation uint8
+
+const (
+	// Inserted - the key was inserted into the set
+	Inserted Operation = iota
+
+	// Removed - the key was removed from the set
+	Removed
+)
+
+// String - returns a string representation of the operation
+func (o Operation) String() string {
+	if o == Inserted {
+		return "inserted"
+	}
+	return "removed"
+}
+
+// Change - represents a single mutation of a Tracked set
+//   - Operation Operation - the kind of mutation
+//   - Key T - the mutated key
+type Change[T comparable] struct {
+	Operation Operation
+	Key       T
+}
+
+// Observer - represents a callback fired for each mutation of a Tracked set
+type Observer[T comparable] func(change Change[T])
+
+// NewTracked - creates a new empty Tracked set with pre-allocated memory for the given size
+func NewTracked[T comparable](size int) *Tracked[T] {
+	return Track(New[T](size))
+}
+
+// Track - creates a new Tracked set over the given set, considering its current contents as committed
+//
+// WARNING: The Tracked set takes ownership of the given set, which must not be modified directly afterward
+func Track[T comparable](set *Set[T]) *Tracked[T] {
+	return &Tracked[T]{
+		set:     set,
+		added:   New[T](0),
+		removed: New[T](0),
+	}
+}
+
+// Tracked - represents a set structure which records its net changes since the last commit, so the changes
+// can be inspected (Changes), accepted (Commit) or reverted (Rollback)
+//   - set *Set[T] - the current contents of the set
+//   - added *Set[T] - the keys inserted since the last commit
+//   - removed *Set[T] - the keys removed since the last commit
+//   - observers []Observer[T] - the callbacks fired for each mutation
+//
+// NOTE: Only the net changes are kept (a key inserted then removed leaves no trace), so the memory used
+// by the tracking is bounded by the size of the difference from the last commit
+type Tracked[T comparable] struct {
+	set       *Set[T]
+	added     *Set[T]
+	removed   *Set[T]
+	observers []Observer[T]
+}
+
+// OnChange - registers an observer, fired for each mutation of the set (including the undo of each net change by Rollback)
+func (t *Tracked[T]) OnChange(observer Observer[T]) {
+	t.observers = append(t.observers, observer)
+}
+
+// Insert - inserts the key into the set, and returns
+// true if the set was modified (key didn't exist before), false otherwise
+func (t *Tracked[T]) Insert(key T) bool {
+	if !t.set.Insert(key) {
+		return false
+	}
+
+	t.record(Change[T]{Operation: Inserted, Key: key})
+	return true
+}
+
+// InsertSlice - inserts each key from the given slice into the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (t *Tracked[T]) InsertSlice(keys []T) bool {
+	modified := false
+
+	for _, item := range keys {
+		if t.Insert(item) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Remove - removes the key from the set, and returns
+// true if the set was modified (key existed before), false otherwise
+func (t *Tracked[T]) Remove(key T) bool {
+	if !t.set.Remove(key) {
+		return false
+	}
+
+	t.record(Change[T]{Operation: Removed, Key: key})
+	return true
+}
+
+// RemoveSlice - removes each key in keys from the set, and returns
+// true if the set was modified (at least once), false otherwise
+func (t *Tracked[T]) RemoveSlice(keys []T) bool {
+	modified := false
+
+	for _, item := range keys {
+		if t.Remove(item) {
+			modified = true
+		}
+	}
+
+	return modified
+}
+
+// Has - returns true if key exists in the set, false otherwise
+func (t *Tracked[T]) Has(key T) bool {
+	return t.set.Has(key)
+}
+
+// HasSlice - returns true if all keys are present in the set, false otherwise
+//
+// NOTE: This method will return true for an empty slice
+func (t *Tracked[T]) HasSlice(keys []T) bool {
+	return t.set.HasSlice(keys)
+}
+
+// Size - returns the cardinality of the set
+func (t *Tracked[T]) Size() int {
+	return t.set.Size()
+}
+
+// Empty - returns true if the set contains no elements, false otherwise
+func (t *Tracked[T]) Empty() bool {
+	return t.set.Empty()
+}
+
+// Slice - returns a copy of the current set as a slice
+func (t *Tracked[T]) Slice() []T {
+	return t.set.Slice()
+}
+
+// ForEach - iterates over the set, calling the given function `f` for each key
+func (t *Tracked[T]) ForEach(f func(T)) {
+	t.set.ForEach(f)
+}
+
+// Set - returns a copy of the current contents of the set
+func (t *Tracked[T]) Set() *Set[T] {
+	return t.set.Copy()
+}
+
+// Changes - returns the net changes since the last commit, as copies:
+//
+//	added <- set \ committed, removed <- committed \ set
+func (t *Tracked[T]) Changes() (added, removed *Set[T]) {
+	return t.added.Copy(), t.removed.Copy()
+}
+
+// Dirty - returns true if the set differs from its last committed contents, false otherwise
+func (t *Tracked[T]) Dirty() bool {
+	return !t.added.Empty() || !t.removed.Empty()
+}
+
+// Commit - accepts the current contents of the set, and clears the changes
+func (t *Tracked[T]) Commit() {
+	t.added = New[T](0)
+	t.removed = New[T](0)
+}
+
+// Rollback - reverts the set to its last committed contents, undoing the net changes (each undo being
+// reported once to the observers), and returns true if the set was modified, false otherwise
+func (t *Tracked[T]) Rollback() bool {
+	added, removed := t.added, t.removed
+	t.Commit()
+
+	for key := range added.keys {
+		t.set.Remove(key)
+		t.notify(Change[T]{Operation: Removed, Key: key})
+	}
+
+	for key := range removed.keys {
+		t.set.Insert(key)
+		t.notify(Change[T]{Operation: Inserted, Key: key})
+	}
+
+	return !added.Empty() || !removed.Empty()
+}
+
+// record - records the mutation in the net changes, and notifies the observers
+func (t *Tracked[T]) record(change Change[T]) {
+	if change.Operation == Inserted {
+		if !t.removed.Remove(change.Key) {
+			t.added.Insert(change.Key)
+		}
+	} else {
+		if !t.added.Remove(change.Key) {
+			t.removed.Insert(change.Key)
+		}
+	}
+
+	t.notify(change)
+}
+
+// notify - fires the observers for the given mutation
+func (t *Tracked[T]) notify(change Change[T]) {
+	for _, observer := range t.observers {
+		observer(change)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2025 Andrei Casu-Pop
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated
+ * documentation files (the "Software"), to deal in the Software without restriction, including without limitation the
+ * rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to
+ * permit persons to whom the Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial portions of the
+ * Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE
+ * WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR
+ * OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracked_Changes(t *testing.T) {
+	tracked := Track(From([]int{1, 2, 3}))
+	assert.False(t, tracked.Dirty())
+
+	assert.True(t, tracked.Insert(4))
+	assert.False(t, tracked.Insert(4))
+	assert.True(t, tracked.Remove(1))
+	assert.True(t, tracked.Remove(4))
+	assert.True(t, tracked.Insert(1))
+	assert.True(t, tracked.InsertSlice([]int{5, 6}))
+	assert.True(t, tracked.Remove(2))
+
+	added, removed := tracked.Changes()
+	assert.True(t, added.EqualSlice([]int{5, 6}))
+	assert.True(t, removed.EqualSlice([]int{2}))
+
+	tracked.Commit()
+	assert.False(t, tracked.Dirty())
+
+	added, removed = tracked.Changes()
+	assert.True(t, added.Empty())
+	assert.True(t, removed.Empty())
+	assert.True(t, tracked.Set().EqualSlice([]int{1, 3, 5, 6}))
+}
+
+func TestTracked_Rollback(t *testing.T) {
+	tracked := Track(From([]int{1, 2, 3}))
+
+	tracked.InsertSlice([]int{4, 5})
+	tracked.RemoveSlice([]int{1, 4})
+	assert.True(t, tracked.Dirty())
+
+	changes := make([]Change[int], 0)
+	tracked.OnChange(func(change Change[int]) {
+		changes = append(changes, change)
+	})
+
+	assert.True(t, tracked.Rollback())
+	assert.False(t, tracked.Rollback())
+	assert.False(t, tracked.Dirty())
+	assert.True(t, tracked.Set().EqualSlice([]int{1, 2, 3}))
+	assert.ElementsMatch(t, []Change[int]{
+		{Operation: Inserted, Key: 1},
+		{Operation: Removed, Key: 5},
+	}, changes)
+
+	tracked.Insert(7)
+	assert.Equal(t, Change[int]{Operation: Inserted, Key: 7}, changes[len(changes)-1])
+	assert.Equal(t, "inserted", Inserted.String())
+}
+
+func TestTracked_RollbackNoNetChange(t *testing.T) {
+	tracked := Track(From([]int{1, 2, 3}))
+
+	changes := 0
+	tracked.OnChange(func(Change[int]) {
+		changes++
+	})
+
+	assert.True(t, tracked.Insert(4))
+	assert.True(t, tracked.Remove(4))
+	assert.True(t, tracked.Remove(1))
+	assert.True(t, tracked.Insert(1))
+	assert.Equal(t, 4, changes)
+	assert.False(t, tracked.Dirty())
+
+	assert.False(t, tracked.Rollback())
+	assert.Equal(t, 4, changes)
+	assert.True(t, tracked.Set().EqualSlice([]int{1, 2, 3}))
+}